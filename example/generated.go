@@ -79,6 +79,10 @@ query getUser ($Login: String!) {
 }
 `
 
+// The SHA-256 hash of getUser_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const getUser_OperationHash = "021acc3fea53acb76f2e61b747c757186f755c727dbb256e0ef636a150b75933"
+
 // getUser gets the given user's name from their username.
 func getUser(
 	ctx_ context.Context,
@@ -88,6 +92,7 @@ func getUser(
 	req_ := &graphql.Request{
 		OpName: "getUser",
 		Query:  getUser_Operation,
+		Hash:   getUser_OperationHash,
 		Variables: &__getUserInput{
 			Login: Login,
 		},
@@ -115,6 +120,10 @@ query getViewer {
 }
 `
 
+// The SHA-256 hash of getViewer_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const getViewer_OperationHash = "c9cb6dd4002fe7d8d11fcf72c8331af428dc5db6e6d5c98a859994315d68ab58"
+
 func getViewer(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -122,6 +131,7 @@ func getViewer(
 	req_ := &graphql.Request{
 		OpName: "getViewer",
 		Query:  getViewer_Operation,
+		Hash:   getViewer_OperationHash,
 	}
 
 	data_ = &getViewerResponse{}
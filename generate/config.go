@@ -25,21 +25,39 @@ type Config struct {
 	// The following fields are documented in the [genqlient.yaml docs].
 	//
 	// [genqlient.yaml docs]: https://github.com/Khan/genqlient/blob/main/docs/genqlient.yaml
-	Schema              StringList              `yaml:"schema"`
-	Operations          StringList              `yaml:"operations"`
-	Generated           string                  `yaml:"generated"`
-	Package             string                  `yaml:"package"`
-	ExportOperations    string                  `yaml:"export_operations"`
-	ContextType         string                  `yaml:"context_type"`
-	ClientGetter        string                  `yaml:"client_getter"`
-	Bindings            map[string]*TypeBinding `yaml:"bindings"`
-	PackageBindings     []*PackageBinding       `yaml:"package_bindings"`
-	Casing              Casing                  `yaml:"casing"`
-	Optional            string                  `yaml:"optional"`
-	OptionalGenericType string                  `yaml:"optional_generic_type"`
-	StructReferences    bool                    `yaml:"use_struct_references"`
-	Extensions          bool                    `yaml:"use_extensions"`
-	Flatten             bool                    `yaml:"flatten"`
+	Schema                 StringList              `yaml:"schema"`
+	Operations             StringList              `yaml:"operations"`
+	Generated              string                  `yaml:"generated"`
+	SplitOutput            string                  `yaml:"split_output"`
+	Package                string                  `yaml:"package"`
+	ExportOperations       string                  `yaml:"export_operations"`
+	ExportOperationsFormat string                  `yaml:"export_operations_format"`
+	ContextType            string                  `yaml:"context_type"`
+	ClientGetter           string                  `yaml:"client_getter"`
+	Bindings               map[string]*TypeBinding `yaml:"bindings"`
+	PackageBindings        []*PackageBinding       `yaml:"package_bindings"`
+	Casing                 Casing                  `yaml:"casing"`
+	Optional               string                  `yaml:"optional"`
+	OptionalGenericType    string                  `yaml:"optional_generic_type"`
+	DefaultBindings        bool                    `yaml:"default_bindings"`
+	StructReferences       bool                    `yaml:"use_struct_references"`
+	Extensions             bool                    `yaml:"use_extensions"`
+	Flatten                bool                    `yaml:"flatten"`
+	DedupeTypes            bool                    `yaml:"dedupe_types"`
+	UnknownImplementations bool                    `yaml:"unknown_implementations"`
+	UnknownEnumValues      string                  `yaml:"unknown_enum_values"`
+	DeprecatedUsage        string                  `yaml:"deprecated_usage"`
+	Templates              StringList              `yaml:"templates"`
+
+	// Projects, if set, are the packages into which to generate code, each
+	// with its own operations and generated (and perhaps other options).  In
+	// this case, the other options in this Config are only defaults for the
+	// projects, and the schema is shared by all of them.
+	//
+	// ReadAndValidateConfig fills in each project with the defaults from the
+	// top level of genqlient.yaml; callers who construct a Config directly
+	// must fill in each project themselves (except for Schema).
+	Projects []*Config `yaml:"projects"`
 
 	// The directory of the config-file (relative to which all the other paths
 	// are resolved).  Set by ValidateAndFillDefaults.
@@ -135,6 +153,52 @@ func (casing *Casing) forEnum(graphQLTypeName string) CasingAlgorithm {
 	return casing.getDefault()
 }
 
+// allProjects returns the config of each project, or just the config itself
+// if it has no projects.
+func (c *Config) allProjects() []*Config {
+	if len(c.Projects) > 0 {
+		return c.Projects
+	}
+	return []*Config{c}
+}
+
+// validateAndFillProjects is the equivalent of ValidateAndFillDefaults for a
+// config with projects.
+func (c *Config) validateAndFillProjects(baseDir string) error {
+	if len(c.Operations) > 0 || c.Generated != "" || c.Package != "" || c.ExportOperations != "" {
+		return errorf(nil, "operations, generated, package, and export_operations "+
+			"must be set in each project, not at the top level")
+	}
+
+	c.baseDir = baseDir
+	for i := range c.Schema {
+		c.Schema[i] = pathJoin(baseDir, c.Schema[i])
+	}
+
+	generated := map[string]int{}
+	for i, project := range c.Projects {
+		if len(project.Schema) > 0 || len(project.Projects) > 0 {
+			return errorf(nil, "projects may not set schema or projects")
+		}
+		if len(project.Operations) == 0 {
+			return errorf(nil, "project %d must set operations", i+1)
+		}
+
+		err := project.ValidateAndFillDefaults(baseDir)
+		if err != nil {
+			return errorf(nil, "project %d: %v", i+1, err)
+		}
+		project.Schema = c.Schema
+
+		if other, ok := generated[project.Generated]; ok {
+			return errorf(nil, "projects %d and %d both generate %v",
+				other+1, i+1, project.Generated)
+		}
+		generated[project.Generated] = i
+	}
+	return nil
+}
+
 // pathJoin is like filepath.Join but 1) it only takes two arguments,
 // and 2) if the second argument is an absolute path the first argument
 // is ignored (similar to how python's os.path.join() works).
@@ -197,6 +261,10 @@ func getPackageNameAndPath(filename string) (pkgName, pkgPath string, err error)
 // The argument is the directory relative to which paths will be interpreted,
 // typically the directory of the config file.
 func (c *Config) ValidateAndFillDefaults(baseDir string) error {
+	if len(c.Projects) > 0 {
+		return c.validateAndFillProjects(baseDir)
+	}
+
 	c.baseDir = baseDir
 	for i := range c.Schema {
 		c.Schema[i] = pathJoin(baseDir, c.Schema[i])
@@ -211,13 +279,48 @@ func (c *Config) ValidateAndFillDefaults(baseDir string) error {
 	if c.ExportOperations != "" {
 		c.ExportOperations = pathJoin(baseDir, c.ExportOperations)
 	}
+	for i := range c.Templates {
+		c.Templates[i] = pathJoin(baseDir, c.Templates[i])
+	}
 
 	if c.ContextType == "" {
 		c.ContextType = "context.Context"
 	}
 
-	if c.Optional != "" && c.Optional != "value" && c.Optional != "pointer" && c.Optional != "pointer_omitempty" && c.Optional != "generic" {
-		return errorf(nil, "optional must be one of: 'value' (default), 'pointer', 'pointer_omitempty' or 'generic'")
+	if c.Optional != "" && c.Optional != "value" && c.Optional != "pointer" && c.Optional != "pointer_omitempty" && c.Optional != "generic" && c.Optional != "omittable" {
+		return errorf(nil, "optional must be one of: 'value' (default), 'pointer', 'pointer_omitempty', 'generic' or 'omittable'")
+	}
+
+	switch c.UnknownEnumValues {
+	case "":
+		c.UnknownEnumValues = "keep"
+	case "keep", "map", "reject":
+	default:
+		return errorf(nil, "unknown_enum_values must be one of: 'keep' (default), 'map', or 'reject'")
+	}
+
+	switch c.DeprecatedUsage {
+	case "":
+		c.DeprecatedUsage = "warn"
+	case "warn", "error", "ignore":
+	default:
+		return errorf(nil, "deprecated_usage must be one of: 'warn' (default), 'error', or 'ignore'")
+	}
+
+	switch c.ExportOperationsFormat {
+	case "":
+		c.ExportOperationsFormat = "genqlient"
+	case "genqlient", "apollo", "relay":
+	default:
+		return errorf(nil, "export_operations_format must be one of: 'genqlient' (default), 'apollo', or 'relay'")
+	}
+
+	switch c.SplitOutput {
+	case "":
+		c.SplitOutput = "none"
+	case "none", "file", "operation":
+	default:
+		return errorf(nil, "split_output must be one of: 'none' (default), 'file', or 'operation'")
 	}
 
 	if c.Optional == "generic" && c.OptionalGenericType == "" {
@@ -333,6 +436,13 @@ func ReadAndValidateConfig(filename string) (*Config, error) {
 		return nil, errorf(nil, "invalid config file %v: %v", filename, err)
 	}
 
+	if len(config.Projects) > 0 {
+		err = config.inheritProjectDefaults(text)
+		if err != nil {
+			return nil, errorf(nil, "invalid config file %v: %v", filename, err)
+		}
+	}
+
 	err = config.ValidateAndFillDefaults(filepath.Dir(filename))
 	if err != nil {
 		return nil, errorf(nil, "invalid config file %v: %v", filename, err)
@@ -341,6 +451,48 @@ func ReadAndValidateConfig(filename string) (*Config, error) {
 	return &config, nil
 }
 
+// inheritProjectDefaults replaces c.Projects with the projects from the given
+// config file, each with the settings from the top level of the file as
+// defaults.
+//
+// To do so, for each project we decode the whole file (sans projects), and
+// then decode the project on top.  This way any option the project sets,
+// even to its zero value, overrides the default, while maps such as bindings
+// are merged.
+func (c *Config) inheritProjectDefaults(text []byte) error {
+	var raw struct {
+		Projects []yaml.Node `yaml:"projects"`
+	}
+	err := yaml.Unmarshal(text, &raw)
+	if err != nil {
+		return err
+	}
+
+	for i := range raw.Projects {
+		if len(c.Projects[i].Schema) > 0 {
+			return errorf(nil, "projects may not set schema; "+
+				"it's shared by all projects")
+		}
+		if len(c.Projects[i].Projects) > 0 {
+			return errorf(nil, "projects may not have their own projects")
+		}
+
+		var project Config
+		err = yaml.Unmarshal(text, &project)
+		if err != nil {
+			return err
+		}
+		project.Schema = nil
+		project.Projects = nil
+		err = raw.Projects[i].Decode(&project)
+		if err != nil {
+			return err
+		}
+		c.Projects[i] = &project
+	}
+	return nil
+}
+
 // ReadAndValidateConfigFromDefaultLocations looks for a config file in the
 // current directory, and all parent directories walking up the tree. The
 // closest config file will be returned.
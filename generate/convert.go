@@ -54,6 +54,46 @@ func (g *generator) getType(
 	return typ, nil
 }
 
+// typeNameSource describes where a Go type-name came from.
+type typeNameSource struct {
+	// A description of the thing for which we generated the name, such as
+	// "field MyQuery.myField (of type MyType)".  Two types with the same
+	// name must have the same description.
+	desc string
+	pos  *ast.Position
+}
+
+// claimTypeName records that we are using the given Go type-name for the type
+// described by desc (at pos), and returns an error if we already used it for
+// something else.
+//
+// Since type-names are built by concatenating field and type names (see
+// names.go), the names for different fields can collide; if they did, we'd
+// either generate a confusing error (if the types differ) or quietly use one
+// type for both (if they don't).  Instead, we report both fields, so the user
+// can choose a different name for one.  (Types with the same description are
+// expected to share a name: for example, the type of an interface's field is
+// converted once for each implementation, and the typename option may be
+// used to share a type.  In this case getType checks that they match.)
+func (g *generator) claimTypeName(name, desc string, pos *ast.Position) error {
+	existing, ok := g.typeNameSources[name]
+	if !ok {
+		g.typeNameSources[name] = typeNameSource{desc, pos}
+		return nil
+	}
+	if existing.desc == desc {
+		return nil
+	}
+
+	existingDesc := existing.desc
+	if existing.pos != nil && existing.pos.Src != nil {
+		existingDesc = fmt.Sprintf("%s at %s", existingDesc, positionString(existing.pos))
+	}
+	return errorf(pos, "conflicting type-name %s: generated for both %s and "+
+		"%s; add `# @genqlient(typename: \"...\")` to one of them to give "+
+		"it a different name", name, desc, existingDesc)
+}
+
 // addType inserts the type into g.typeMap, checking for conflicts.
 //
 // The conflict-checking is as described in getType.  Note we have to do it
@@ -98,6 +138,10 @@ func (g *generator) convertOperation(
 		name = queryOptions.TypeName
 		namePrefix = newPrefixList(queryOptions.TypeName)
 	}
+	err := g.claimTypeName(name, "operation "+operation.Name, operation.Position)
+	if err != nil {
+		return nil, err
+	}
 
 	baseType, err := g.baseTypeForOperation(operation.Operation)
 	if err != nil {
@@ -116,8 +160,10 @@ func (g *generator) convertOperation(
 	// It's not common to use a fragment-spread for the whole query, but you
 	// can if you want two queries to return the same type!
 	if queryOptions.GetFlatten() {
+		// (Flattening a field's selection, rather than a fragment-spread,
+		// is only supported on fields: the response can't be flattened.)
 		i, err := validateFlattenOption(baseType, operation.SelectionSet, operation.Position)
-		if err == nil {
+		if err == nil && !isFieldSelection(operation.SelectionSet[i]) {
 			return fields[i].GoType, nil
 		}
 	}
@@ -252,6 +298,9 @@ func (g *generator) convertType(
 				options.Omitempty = &oe
 			}
 		}
+		if !typ.NonNull {
+			return g.maybeOmittable(&goSliceType{elem})
+		}
 		return &goSliceType{elem}, nil
 	}
 
@@ -296,11 +345,39 @@ func (g *generator) convertType(
 			GoGenericRef: genericRef,
 			Elem:         goTyp,
 		}
+	} else if !typ.NonNull {
+		return g.maybeOmittable(goTyp)
 	}
 
 	return goTyp, nil
 }
 
+// maybeOmittable wraps the given type (of a nullable field) in
+// graphql.Omittable, if so configured.
+//
+// We don't wrap types which need special handling when marshaling, such as
+// interfaces (or lists of them); their (un)marshal-helpers don't know about
+// Omittable.
+func (g *generator) maybeOmittable(typ goType) (goType, error) {
+	if g.Config.Optional != "omittable" {
+		return typ, nil
+	}
+	switch elem := typ.Unwrap().(type) {
+	case *goInterfaceType, *goFlattenedType:
+		return typ, nil
+	case *goOpaqueType:
+		if elem.Marshaler != "" || elem.Unmarshaler != "" {
+			return typ, nil
+		}
+	}
+
+	omittableRef, err := g.ref("github.com/Khan/genqlient/graphql.Omittable")
+	if err != nil {
+		return nil, err
+	}
+	return &goGenericType{GoGenericRef: omittableRef, Elem: typ, Omittable: true}, nil
+}
+
 // getStructReference decides if a field should be of pointer type and have the omitempty flag set.
 func (g *generator) getStructReference(
 	def *ast.Definition,
@@ -328,6 +405,9 @@ func (g *generator) convertDefinition(
 	// bindings are checked in the caller (convertType) and never get here,
 	// unless the binding is "-" which means "ignore the global binding".
 	globalBinding, ok := g.Config.Bindings[def.Name]
+	if !ok && def.Kind == ast.Scalar && g.Config.DefaultBindings {
+		globalBinding, ok = g.defaultBinding(def)
+	}
 	if ok && options.Bind != "-" {
 		if options.TypeName != "" {
 			// The option position (in the query) is more useful here.
@@ -354,9 +434,22 @@ func (g *generator) convertDefinition(
 	if ok && options.TypeName == "" {
 		return &goOpaqueType{GoRef: goBuiltinName, GraphQLName: def.Name}, nil
 	}
+	if def.Kind == ast.Scalar && !ok {
+		// (If you had an entry in bindings, or default_bindings is set, we
+		// would have returned it above.)
+		return nil, errorf(
+			pos, "unknown scalar %v: please add it to \"bindings\" in genqlient.yaml"+
+				"\nExample: https://github.com/Khan/genqlient/blob/main/example/genqlient.yaml#L12", def.Name)
+	}
 
-	// Determine the name to use for this type.
-	var name string
+	// Determine the name to use for this type, and what it's the name of, in
+	// case it conflicts.  (The position of the field, if we have one, is
+	// more useful than that of the type-definition.)
+	var name, nameDesc string
+	namePos := pos
+	if namePrefix != nil && namePrefix.pos != nil {
+		namePos = namePrefix.pos
+	}
 	if options.TypeName != "" {
 		if goKeywords[options.TypeName] {
 			return nil, errorf(pos, "typename option must not be a go keyword")
@@ -372,6 +465,7 @@ func (g *generator) convertDefinition(
 		}
 		// (But the prefix is shared.)
 		namePrefix = newPrefixList(options.TypeName)
+		nameDesc = fmt.Sprintf("typename %s (of type %s)", options.TypeName, def.Name)
 	} else if def.Kind == ast.InputObject || def.Kind == ast.Enum {
 		// If we're an input-object or enum, there is only one type we will
 		// ever possibly generate for this type, so we don't need any of the
@@ -379,9 +473,14 @@ func (g *generator) convertDefinition(
 		// likely to need to reference these types in their code.
 		name = ApplyCasing(def.Name, g.Config.GetDefaultCasingAlgorithm(), true)
 		// (namePrefix is ignored in this case.)
+		nameDesc = "type " + def.Name
 	} else {
 		// Else, construct a name using the usual algorithm (see names.go).
 		name = makeTypeName(namePrefix, def.Name, g.Config.GetDefaultCasingAlgorithm())
+		nameDesc = fmt.Sprintf("field %s (of type %s)", namePrefix.path, def.Name)
+	}
+	if err := g.claimTypeName(name, nameDesc, namePos); err != nil {
+		return nil, err
 	}
 
 	// If we already generated the type, we can skip it as long as it matches
@@ -420,14 +519,20 @@ func (g *generator) convertDefinition(
 			// figure out a good way to do it before/while constructing the
 			// fields, rather than after.
 			i, err := validateFlattenOption(def, selectionSet, pos)
-			if err == nil {
+			if err == nil && !isFieldSelection(selectionSet[i]) {
 				return fields[i].GoType, nil
+			} else if err == nil && options.localFlatten {
+				// Flattening a field's selection changes the shape of the
+				// JSON, so we only do it if requested for this field
+				// specifically.
+				return g.convertFlattenedField(name, fields[i], selectionSet, desc, pos)
 			}
 		}
 
 		goType := &goStructType{
 			GoName:          name,
 			Fields:          fields,
+			UserNamed:       options.TypeName != "",
 			Selection:       selectionSet,
 			descriptionInfo: desc,
 			Generator:       g,
@@ -493,12 +598,14 @@ func (g *generator) convertDefinition(
 				}
 			}
 
+			deprecation, _ := deprecationReason(field.Directives)
 			goType.Fields[i] = &goStructField{
 				GoName:      goName,
 				GoType:      fieldGoType,
 				JSONName:    field.Name,
 				GraphQLName: field.Name,
-				Description: field.Description,
+				Description: withDeprecationReason(field.Description, deprecation),
+				Deprecation: deprecation,
 				Omitempty:   fieldOptions.GetOmitempty(),
 			}
 		}
@@ -514,8 +621,13 @@ func (g *generator) convertDefinition(
 		// __typename), and it's shared.
 		if options.GetFlatten() {
 			i, err := validateFlattenOption(def, selectionSet, pos)
-			if err == nil {
+			if err == nil && !isFieldSelection(selectionSet[i]) {
 				return sharedFields[i].GoType, nil
+			} else if err == nil && options.localFlatten {
+				// Flattening a field's selection changes the shape of the
+				// JSON, so we only do it if requested for this field
+				// specifically.
+				return g.convertFlattenedField(name, sharedFields[i], selectionSet, desc, pos)
 			}
 		}
 
@@ -550,14 +662,21 @@ func (g *generator) convertDefinition(
 			}
 			goType.Implementations[i] = implStructTyp
 		}
+		if options.GetUnknownImplementations() {
+			// The option position (in the query) is more useful here.
+			if err := g.addUnknownImplementation(goType, options.pos); err != nil {
+				return nil, err
+			}
+		}
 		return g.addType(goType, goType.GoName, pos)
 
 	case ast.Enum:
 		goType := &goEnumType{
-			GoName:      name,
-			GraphQLName: def.Name,
-			Description: def.Description,
-			Values:      make([]goEnumValue, len(def.EnumValues)),
+			GoName:        name,
+			GraphQLName:   def.Name,
+			Description:   def.Description,
+			Values:        make([]goEnumValue, len(def.EnumValues)),
+			UnknownValues: g.Config.UnknownEnumValues,
 		}
 		goNames := make(map[string]*goEnumValue, len(def.EnumValues))
 		for i, val := range def.EnumValues {
@@ -573,33 +692,153 @@ func (g *generator) convertDefinition(
 			goType.Values[i] = goEnumValue{
 				GoName:      goName,
 				GraphQLName: val.Name,
-				Description: val.Description,
+				Description: withDeprecation(val.Description, val.Directives),
 			}
 			goNames[goName] = &goType.Values[i]
 		}
+		if goType.UnknownValues == "map" {
+			for i, val := range goType.Values {
+				if val.GoName == goType.unknownValueGoName() {
+					return nil, errorf(def.EnumValues[i].Position,
+						"enum value %s has Go name %s, which conflicts with "+
+							"the constant for unknown values; add "+
+							"'enums: %v: raw' to 'casing' in genqlient.yaml, "+
+							"or set 'unknown_enum_values' to 'keep' or "+
+							"'reject', to fix",
+						val.GraphQLName, val.GoName, def.Name)
+				}
+			}
+		}
 		return g.addType(goType, goType.GoName, pos)
 
 	case ast.Scalar:
-		if builtinTypes[def.Name] != "" {
-			// In this case, the user asked for a custom Go type-name
-			// for a built-in type, e.g. `type MyString string`.
-			goType := &goTypenameForBuiltinType{
-				GoTypeName:    name,
-				GoBuiltinName: builtinTypes[def.Name],
-				GraphQLName:   def.Name,
-			}
-			return g.addType(goType, goType.GoTypeName, pos)
+		// In this case, the user asked for a custom Go type-name for a
+		// built-in type, e.g. `type MyString string`.  (Other scalars are
+		// handled above.)
+		goType := &goTypenameForBuiltinType{
+			GoTypeName:    name,
+			GoBuiltinName: builtinTypes[def.Name],
+			GraphQLName:   def.Name,
 		}
-
-		// (If you had an entry in bindings, we would have returned it above.)
-		return nil, errorf(
-			pos, "unknown scalar %v: please add it to \"bindings\" in genqlient.yaml"+
-				"\nExample: https://github.com/Khan/genqlient/blob/main/example/genqlient.yaml#L12", def.Name)
+		return g.addType(goType, goType.GoTypeName, pos)
 	default:
 		return nil, errorf(pos, "unexpected kind: %v", def.Kind)
 	}
 }
 
+// isFieldSelection returns true if the given selection is a field (rather
+// than a fragment).
+func isFieldSelection(selection ast.Selection) bool {
+	_, ok := selection.(*ast.Field)
+	return ok
+}
+
+// convertFlattenedField builds the type for a field with the flatten option
+// whose selection is the single field given.  The Go type is that of the
+// inner field, but since the JSON has an additional layer of nesting, we
+// also generate a wrapper struct, with just the inner field, to
+// (un)marshal it.  See goFlattenedType for more.
+func (g *generator) convertFlattenedField(
+	name string,
+	field *goStructField,
+	selectionSet ast.SelectionSet,
+	desc descriptionInfo,
+	pos *ast.Position,
+) (goType, error) {
+	wrapperName := "__flattened" + name
+	desc.CommentOverride = fmt.Sprintf(
+		"%s is used internally by genqlient to (un)marshal the flattened "+
+			"field %s.", wrapperName, field.JSONName)
+	wrapper := &goStructType{
+		GoName:          wrapperName,
+		Fields:          []*goStructField{field},
+		Selection:       selectionSet,
+		descriptionInfo: desc,
+		Generator:       g,
+	}
+	return g.addType(
+		&goFlattenedType{Wrapper: wrapper, Elem: field.GoType},
+		wrapperName, pos)
+}
+
+// addUnknownImplementation adds to the given interface type an
+// implementation for concrete types which weren't in the schema when we
+// generated the code (perhaps they were added to the server since).  It has
+// the fields shared by all the implementations, as well as the type-name and
+// the raw JSON of the object.
+//
+// As with struct and flatten, this only applies if valid, which is important
+// if you applied it to the whole query; if the user applied it directly to an
+// invalid field, genqlientDirective.validate will already have complained.
+func (g *generator) addUnknownImplementation(typ *goInterfaceType, pos *ast.Position) error {
+	name := typ.GoName + "Unknown"
+	fields := make([]*goStructField, 0, len(typ.SharedFields)+2)
+	hasTypename := false
+	for _, field := range typ.SharedFields {
+		if field.IsEmbedded() || field.GoName == "Raw" {
+			// The embedded fragment is itself an interface, which doesn't know
+			// about this implementation; and a field named Raw would conflict
+			// with ours.
+			return nil
+		}
+		hasTypename = hasTypename || field.GraphQLName == "__typename"
+		fields = append(fields, field)
+	}
+	if !hasTypename {
+		// (This happens for named fragments, where we don't add __typename;
+		// but we need it to marshal the type correctly.)
+		fields = append([]*goStructField{{
+			GoName:      "Typename",
+			GoType:      &goOpaqueType{GoRef: "string", GraphQLName: "String"},
+			JSONName:    "__typename",
+			GraphQLName: "__typename",
+		}}, fields...)
+	}
+
+	rawRef, err := g.ref("encoding/json.RawMessage")
+	if err != nil {
+		return err
+	}
+	fields = append(fields, &goStructField{
+		GoName:      "Raw",
+		GoType:      &goOpaqueType{GoRef: rawRef},
+		JSONName:    "-",
+		Description: "Raw is the JSON of the object, as returned by the server.",
+	})
+
+	unknown := &goStructType{
+		GoName:    name,
+		Fields:    fields,
+		Selection: typ.Selection,
+		descriptionInfo: descriptionInfo{
+			CommentOverride: fmt.Sprintf(
+				"%s is the implementation of %s for GraphQL types which "+
+					"were not known when this code was generated.  It "+
+					"includes the requested fields shared by all types.",
+				name, typ.GoName),
+			GraphQLName: typ.GraphQLName,
+		},
+		Generator: g,
+	}
+	err = g.claimTypeName(name, "unknown implementations of "+typ.GoName, pos)
+	if err != nil {
+		return err
+	}
+	for _, impl := range typ.Implementations {
+		if impl.GoName == name {
+			return errorf(pos, "unknown_implementations would generate a type "+
+				"%s, which conflicts with the implementation for %s",
+				name, impl.GraphQLName)
+		}
+	}
+	existing, err := g.addType(unknown, name, pos)
+	if err != nil {
+		return err
+	}
+	typ.Unknown, _ = existing.(*goStructType)
+	return nil
+}
+
 // convertSelectionSet converts a GraphQL selection-set into a list of
 // corresponding Go struct-fields (and their Go types)
 //
@@ -696,7 +935,7 @@ func (g *generator) convertSelectionSet(
 				// has scalar/enum type iff this field does:
 				// https://spec.graphql.org/draft/#SameResponseShape()
 				continue
-			case *goStructType, *goInterfaceType:
+			case *goStructType, *goInterfaceType, *goFlattenedType:
 				// TODO(benkraft): Keep track of the position of each
 				// selection, so we can put this error on the right line.
 				return nil, errorf(nil,
@@ -778,12 +1017,73 @@ func (g *generator) convertInlineFragment(
 ) ([]*goStructField, error) {
 	// You might think fragmentTypedef is just fragment.ObjectDefinition, but
 	// actually that's the type into which the fragment is spread.
-	fragmentTypedef := g.schema.Types[fragment.TypeCondition]
-	if !fragmentMatches(containingTypedef, fragmentTypedef) {
-		return nil, nil
+	// (If there's no type-condition, as in `... @defer { myField }`, the
+	// fragment always applies.)
+	if fragment.TypeCondition != "" {
+		fragmentTypedef := g.schema.Types[fragment.TypeCondition]
+		if !fragmentMatches(containingTypedef, fragmentTypedef) {
+			return nil, nil
+		}
+	}
+	if isConditional(fragment.Directives) && !queryOptions.conditional {
+		// The fields of the fragment may be omitted from the response; see
+		// convertField.
+		conditionalOptions := *queryOptions
+		conditionalOptions.conditional = true
+		queryOptions = &conditionalOptions
 	}
-	return g.convertSelectionSet(namePrefix, fragment.SelectionSet,
+	fields, err := g.convertSelectionSet(namePrefix, fragment.SelectionSet,
 		containingTypedef, queryOptions)
+	if err != nil || !isDeferred(fragment.Directives) {
+		return fields, err
+	}
+
+	// The fields of a deferred fragment are missing from the initial
+	// response, and arrive in a later payload (see docs/incremental.md), so
+	// we need to be able to represent "not arrived yet": we make each field
+	// a pointer (unless it's already nillable), which will be nil until then.
+	for _, field := range fields {
+		if field.IsEmbedded() {
+			// The embedded fragment's fields would need to be nillable too,
+			// but it may be used elsewhere without @defer.
+			return nil, errorf(fragment.Position,
+				"@defer is not supported for inline fragments containing "+
+					"fragment spreads; move the spread out of the deferred "+
+					"fragment")
+		}
+		if field.GoType.SliceDepth() == 0 && !field.GoType.IsPointer() && !field.IsAbstract() {
+			field.GoType = &goPointerType{field.GoType}
+		}
+		field.Omitempty = true
+	}
+	return fields, nil
+}
+
+// isDeferred returns true if the given directives (of a fragment) include
+// @defer, unless it's explicitly disabled with `@defer(if: false)`.
+func isDeferred(directives ast.DirectiveList) bool {
+	directive := directives.ForName("defer")
+	if directive == nil {
+		return false
+	}
+	arg := directive.Arguments.ForName("if")
+	return arg == nil || arg.Value.Raw != "false"
+}
+
+// isConditional returns true if the given directives (of a field or
+// fragment) include @include or @skip, such that it may be omitted from the
+// response depending on the variables, unless the condition is a constant
+// which says it will always be included.
+func isConditional(directives ast.DirectiveList) bool {
+	alwaysIncluded := func(directiveName, value string) bool {
+		directive := directives.ForName(directiveName)
+		if directive == nil {
+			return true
+		}
+		arg := directive.Arguments.ForName("if")
+		return arg != nil && arg.Value.Kind == ast.BooleanValue && arg.Value.Raw == value
+	}
+	return !alwaysIncluded("include", "true") || !alwaysIncluded("skip", "false")
 }
 
 // convertFragmentSpread converts a single GraphQL fragment-spread
@@ -798,6 +1098,14 @@ func (g *generator) convertFragmentSpread(
 	if !fragmentMatches(containingTypedef, fragmentSpread.Definition.Definition) {
 		return nil, nil
 	}
+	if isDeferred(fragmentSpread.Directives) {
+		// We'd need to embed a pointer to the fragment, which would need
+		// special handling when unmarshaling to tell if it has arrived.
+		return nil, errorf(fragmentSpread.Position,
+			"@defer is not yet supported on fragment spreads, only on "+
+				"inline fragments like `... @defer { myField }`; see "+
+				"https://github.com/Khan/genqlient/blob/main/docs/incremental.md#known-limitation-deferred-fragment-spreads")
+	}
 
 	typ, ok := g.typeMap[fragmentSpread.Name]
 	if !ok {
@@ -846,6 +1154,12 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 		return nil, err
 	}
 
+	fragmentDesc := fmt.Sprintf("fragment %s (of type %s)", fragment.Name, typ.Name)
+	err = g.claimTypeName(fragment.Name, fragmentDesc, fragment.Position)
+	if err != nil {
+		return nil, err
+	}
+
 	desc := descriptionInfo{
 		CommentOverride:    comment,
 		GraphQLName:        typ.Name,
@@ -856,6 +1170,15 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 	// The rest basically follows how we convert a definition, except that
 	// things like type-names are a bit different.
 
+	if g.conditionalFragments[fragment.Name] {
+		// The fragment is spread with @include or @skip somewhere, so its
+		// fields may be omitted from the response; see convertField.  (Its
+		// type is shared by all its spreads, so they all get optional fields.)
+		conditionalDirective := *directive
+		conditionalDirective.conditional = true
+		directive = &conditionalDirective
+	}
+
 	fields, err := g.convertSelectionSet(
 		newPrefixList(fragment.Name), fragment.SelectionSet, typ, directive)
 	if err != nil {
@@ -865,8 +1188,9 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 		// Flatten on a fragment-definition is a bit weird -- it makes one
 		// fragment effectively an alias for another -- but no reason we can't
 		// allow it.
+		// (As with operations, we don't flatten a fragment's field.)
 		i, err := validateFlattenOption(typ, fragment.SelectionSet, fragment.Position)
-		if err == nil {
+		if err == nil && !isFieldSelection(fragment.SelectionSet[i]) {
 			return fields[i].GoType, nil
 		}
 	}
@@ -905,8 +1229,15 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 			implDesc := desc
 			implDesc.GraphQLName = implDef.Name
 
+			implName := fragment.Name + upperFirst(implDef.Name)
+			implNameDesc := fmt.Sprintf("fragment %s (of type %s)", fragment.Name, implDef.Name)
+			err = g.claimTypeName(implName, implNameDesc, fragment.Position)
+			if err != nil {
+				return nil, err
+			}
+
 			implTyp := &goStructType{
-				GoName:          fragment.Name + upperFirst(implDef.Name),
+				GoName:          implName,
 				Fields:          implFields,
 				Selection:       fragment.SelectionSet,
 				descriptionInfo: implDesc,
@@ -915,6 +1246,11 @@ func (g *generator) convertNamedFragment(fragment *ast.FragmentDefinition) (goTy
 			goType.Implementations[i] = implTyp
 			g.typeMap[implTyp.GoName] = implTyp
 		}
+		if directive.GetUnknownImplementations() {
+			if err := g.addUnknownImplementation(goType, fragment.Position); err != nil {
+				return nil, err
+			}
+		}
 
 		return goType, nil
 	default:
@@ -950,18 +1286,41 @@ func (g *generator) convertField(
 
 	namePrefix = nextPrefix(namePrefix, field, g.Config.GetDefaultCasingAlgorithm())
 
+	// A field which may be omitted from the response, because it (or an
+	// enclosing inline fragment) has @include or @skip, is optional even if
+	// its type is non-null.  The optional option overrides all that.
+	typ := field.Definition.Type
+	optional := !typ.NonNull || queryOptions.conditional || isConditional(field.Directives)
+	if fieldOptions.Optional != nil {
+		optional = *fieldOptions.Optional
+	}
+	if optional == typ.NonNull {
+		optionalTyp := *typ
+		optionalTyp.NonNull = !optional
+		typ = &optionalTyp
+	}
+	if queryOptions.conditional {
+		// The field's own selections are not conditional (unless they have
+		// their own @include or @skip).
+		unconditionalOptions := *queryOptions
+		unconditionalOptions.conditional = false
+		queryOptions = &unconditionalOptions
+	}
+
 	fieldGoType, err := g.convertType(
-		namePrefix, field.Definition.Type, field.SelectionSet,
+		namePrefix, typ, field.SelectionSet,
 		fieldOptions, queryOptions)
 	if err != nil {
 		return nil, err
 	}
 
+	deprecation, _ := deprecationReason(field.Definition.Directives)
 	return &goStructField{
 		GoName:      goName,
 		GoType:      fieldGoType,
 		JSONName:    field.Alias,
 		GraphQLName: field.Name,
-		Description: field.Definition.Description,
+		Description: withDeprecationReason(field.Definition.Description, deprecation),
+		Deprecation: deprecation,
 	}, nil
 }
@@ -0,0 +1,162 @@
+package generate
+
+// This file implements the dedupe_types option, which replaces structurally
+// identical response types -- for example, those for several operations
+// which each select `user { id name }` -- with a single shared type.
+//
+// We do this as a pass over the converted types, after all the operations
+// have been converted, so that which name we pick for each shared type
+// doesn't depend on the order of the operations.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dedupeTypes replaces each set of structurally identical struct types in
+// g.typeMap with one of them.
+//
+// Two struct types are identical if they are for the same GraphQL type and
+// have the same fields, with the same names and Go types (which in turn
+// reflect any genqlient directives, like pointer or bind).  We don't share
+// types for which the Go name is part of the API: operation response types,
+// named fragments, input types (which are already shared), and the types of
+// interface and union implementations (which are also distinguished by the
+// interfaces they implement).
+//
+// The shared type keeps the name of one of the types it replaces: a name
+// given with the typename option if there is one, or else the shortest
+// (and then alphabetically first).  Since those names are already unique,
+// this can't introduce a new collision.
+func (g *generator) dedupeTypes() {
+	keep := map[*goStructType]bool{}
+	for _, op := range g.Operations {
+		if typ, ok := g.typeMap[op.ResponseName].(*goStructType); ok {
+			keep[typ] = true
+		}
+	}
+	for _, typ := range g.typeMap {
+		if typ, ok := typ.(*goInterfaceType); ok {
+			for _, impl := range typ.Implementations {
+				keep[impl] = true
+			}
+			if typ.Unknown != nil {
+				keep[typ.Unknown] = true
+			}
+		}
+	}
+
+	// Sharing some types may make the types that refer to them identical, so
+	// we repeat until there's nothing left to share.
+	for {
+		groups := map[string][]*goStructType{}
+		for _, typ := range g.typeMap {
+			typ, ok := typ.(*goStructType)
+			if !ok || typ.IsInput || typ.FragmentName != "" || keep[typ] {
+				continue
+			}
+			key := structKey(typ)
+			groups[key] = append(groups[key], typ)
+		}
+
+		replacements := map[*goStructType]*goStructType{}
+		for _, group := range groups {
+			if len(group) < 2 {
+				continue
+			}
+			sort.Slice(group, func(i, j int) bool {
+				a, b := group[i], group[j]
+				switch {
+				case a.UserNamed != b.UserNamed:
+					return a.UserNamed
+				case len(a.GoName) != len(b.GoName):
+					return len(a.GoName) < len(b.GoName)
+				default:
+					return a.GoName < b.GoName
+				}
+			})
+			for _, typ := range group[1:] {
+				replacements[typ] = group[0]
+				delete(g.typeMap, typ.GoName)
+			}
+		}
+		if len(replacements) == 0 {
+			return
+		}
+
+		for _, typ := range g.typeMap {
+			replaceTypes(typ, replacements)
+		}
+	}
+}
+
+// structKey returns a string which is equal for two struct types if and only
+// if they would generate identical code, up to their names.
+func structKey(typ *goStructType) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s\n%q\n", typ.GraphQLName, typ.CommentOverride)
+	for _, field := range typ.Fields {
+		fmt.Fprintf(&builder, "%s %s %s %v %q %q %s\n",
+			field.GoName, field.JSONName, field.GraphQLName, field.Omitempty,
+			field.Description, field.Deprecation, typeKey(field.GoType))
+	}
+	return builder.String()
+}
+
+// typeKey is like Reference, but also distinguishes types whose references
+// are the same but which are marshaled differently.
+func typeKey(typ goType) string {
+	switch typ := typ.(type) {
+	case *goSliceType:
+		return "[]" + typeKey(typ.Elem)
+	case *goPointerType:
+		return "*" + typeKey(typ.Elem)
+	case *goGenericType:
+		return typ.GoGenericRef + "[" + typeKey(typ.Elem) + "]"
+	case *goOpaqueType:
+		return fmt.Sprintf("%s(%s,%s)", typ.GoRef, typ.Marshaler, typ.Unmarshaler)
+	case *goFlattenedType:
+		return "flattened(" + typ.Wrapper.GoName + ")"
+	default:
+		return typ.Reference()
+	}
+}
+
+// replaceTypes updates the fields of the given type to refer to the
+// replacements of any replaced types.
+func replaceTypes(typ goType, replacements map[*goStructType]*goStructType) {
+	switch typ := typ.(type) {
+	case *goStructType:
+		replaceFieldTypes(typ.Fields, replacements)
+	case *goInterfaceType:
+		replaceFieldTypes(typ.SharedFields, replacements)
+	case *goFlattenedType:
+		typ.Elem = replaceType(typ.Elem, replacements)
+		replaceFieldTypes(typ.Wrapper.Fields, replacements)
+	}
+}
+
+func replaceFieldTypes(fields []*goStructField, replacements map[*goStructType]*goStructType) {
+	for _, field := range fields {
+		field.GoType = replaceType(field.GoType, replacements)
+	}
+}
+
+// replaceType returns the given type, with the replacement of any replaced
+// type it wraps.
+func replaceType(typ goType, replacements map[*goStructType]*goStructType) goType {
+	switch typ := typ.(type) {
+	case *goStructType:
+		if replacement, ok := replacements[typ]; ok {
+			return replacement
+		}
+	case *goSliceType:
+		typ.Elem = replaceType(typ.Elem, replacements)
+	case *goPointerType:
+		typ.Elem = replaceType(typ.Elem, replacements)
+	case *goGenericType:
+		typ.Elem = replaceType(typ.Elem, replacements)
+	}
+	return typ
+}
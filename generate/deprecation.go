@@ -0,0 +1,115 @@
+package generate
+
+// This file handles schema elements marked @deprecated: we report their uses
+// in operations (see Config.DeprecatedUsage), and mark the generated code for
+// them as deprecated, so that tools like staticcheck can flag its uses.
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// defaultDeprecationReason is the default reason for @deprecated, per the
+// GraphQL spec.
+const defaultDeprecationReason = "No longer supported"
+
+// deprecationReason returns the reason given by the @deprecated directive
+// among the given directives (of a schema element), and whether there was
+// one.
+func deprecationReason(directives ast.DirectiveList) (string, bool) {
+	directive := directives.ForName("deprecated")
+	if directive == nil {
+		return "", false
+	}
+	reason := defaultDeprecationReason
+	if arg := directive.Arguments.ForName("reason"); arg != nil && arg.Value != nil && arg.Value.Raw != "" {
+		reason = arg.Value.Raw
+	}
+	return reason, true
+}
+
+// withDeprecation returns the doc-comment for a schema element with the given
+// description and directives: the description, plus, if the element is
+// deprecated, a "Deprecated:" paragraph, per the Go convention.
+func withDeprecation(description string, directives ast.DirectiveList) string {
+	reason, _ := deprecationReason(directives)
+	return withDeprecationReason(description, reason)
+}
+
+// withDeprecationReason is like withDeprecation, but takes the reason (or ""
+// if the element isn't deprecated).
+func withDeprecationReason(description, reason string) string {
+	switch {
+	case reason == "":
+		return description
+	case description == "":
+		return "Deprecated: " + reason
+	default:
+		return description + "\n\nDeprecated: " + reason
+	}
+}
+
+// findDeprecatedUsages returns an error for each use of a deprecated field,
+// argument, input field, or enum value in the given (validated) document,
+// sorted by position.
+func findDeprecatedUsages(schema *ast.Schema, document *ast.QueryDocument) []error {
+	var errs []error
+	var observers validator.Events
+
+	observers.OnField(func(_ *validator.Walker, field *ast.Field) {
+		if field.Definition == nil || field.ObjectDefinition == nil {
+			return
+		}
+		if reason, ok := deprecationReason(field.Definition.Directives); ok {
+			errs = append(errs, errorf(field.Position,
+				"field %s.%s is deprecated: %s",
+				field.ObjectDefinition.Name, field.Name, reason))
+		}
+		for _, arg := range field.Arguments {
+			argDef := field.Definition.Arguments.ForName(arg.Name)
+			if argDef == nil {
+				continue
+			}
+			if reason, ok := deprecationReason(argDef.Directives); ok {
+				errs = append(errs, errorf(arg.Position,
+					"argument %s of field %s.%s is deprecated: %s",
+					arg.Name, field.ObjectDefinition.Name, field.Name, reason))
+			}
+		}
+	})
+
+	observers.OnValue(func(_ *validator.Walker, value *ast.Value) {
+		if value.Definition == nil {
+			return
+		}
+		switch value.Kind {
+		case ast.ObjectValue:
+			for _, child := range value.Children {
+				fieldDef := value.Definition.Fields.ForName(child.Name)
+				if fieldDef == nil {
+					continue
+				}
+				if reason, ok := deprecationReason(fieldDef.Directives); ok {
+					errs = append(errs, errorf(child.Position,
+						"input field %s.%s is deprecated: %s",
+						value.Definition.Name, child.Name, reason))
+				}
+			}
+		case ast.EnumValue:
+			enumValue := value.Definition.EnumValues.ForName(value.Raw)
+			if enumValue == nil {
+				return
+			}
+			if reason, ok := deprecationReason(enumValue.Directives); ok {
+				errs = append(errs, errorf(value.Position,
+					"enum value %s.%s is deprecated: %s",
+					value.Definition.Name, value.Raw, reason))
+			}
+		}
+	})
+
+	validator.Walk(schema, document, &observers)
+	// The walker visits each fragment once for each place it's used (as well
+	// as on its own), so we may have duplicates.
+	return sortAndDedupeErrors(errs)
+}
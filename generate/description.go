@@ -64,6 +64,9 @@ func interfaceDescription(typ *goInterfaceType) string {
 	for i, impl := range typ.Implementations {
 		goImplNames[i] = impl.Reference()
 	}
+	if typ.Unknown != nil {
+		goImplNames = append(goImplNames, typ.Unknown.Reference())
+	}
 	implementationList := fmt.Sprintf(
 		"\n\n%v is implemented by the following types:\n\t%v",
 		typ.GoName, strings.Join(goImplNames, "\n\t"))
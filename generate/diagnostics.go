@@ -0,0 +1,156 @@
+package generate
+
+// This file implements the machine-readable formats for errors and warnings,
+// for --format=json and --format=sarif.
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// diagnostic is a single error or warning, as reported by --format=json.
+type diagnostic struct {
+	// Severity is "error" or "warning".
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// File, Line, and Column are the position of the problem, if known.  For
+	// operations embedded in Go files, they are the position in the Go file.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// newDiagnostics returns the diagnostics for the given warnings and error
+// (which may be nil, or a list of errors).
+func newDiagnostics(warnings []error, err error) []diagnostic {
+	diagnostics := []diagnostic{}
+	add := func(severity string, err error) {
+		d := diagnostic{Severity: severity, Message: err.Error()}
+		var genqlientErr *genqlientError
+		if errors.As(err, &genqlientErr) {
+			d.Message = genqlientErr.msg
+			if genqlientErr.pos != nil {
+				d.File, d.Line = genqlientErr.pos.resolve()
+				d.File = filepath.ToSlash(d.File)
+				d.Column = genqlientErr.pos.col
+			}
+		}
+		// Warnings are written for humans, as "warning: ...\n".
+		d.Message = strings.TrimSpace(strings.TrimPrefix(d.Message, "warning: "))
+		diagnostics = append(diagnostics, d)
+	}
+
+	for _, warning := range warnings {
+		add("warning", warning)
+	}
+	var list errorList
+	if errors.As(err, &list) {
+		for _, err := range list {
+			add("error", err)
+		}
+	} else if err != nil {
+		add("error", err)
+	}
+	return diagnostics
+}
+
+// formatDiagnostics formats the given diagnostics in the given format, "json"
+// or "sarif".
+func formatDiagnostics(format string, diagnostics []diagnostic) ([]byte, error) {
+	var value interface{}
+	switch format {
+	case "json":
+		value = map[string][]diagnostic{"diagnostics": diagnostics}
+	case "sarif":
+		value = sarifLog(diagnostics)
+	default:
+		return nil, errorf(nil, "invalid format %v; expected text, json, or sarif", format)
+	}
+
+	b, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, errorf(nil, "unable to format diagnostics: %v", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// The following types are the (small) subset of SARIF 2.1.0 we need; see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html.
+
+type sarifRoot struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string `json:"name"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifResult struct {
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func sarifLog(diagnostics []diagnostic) *sarifRoot {
+	results := make([]sarifResult, len(diagnostics))
+	for i, d := range diagnostics {
+		results[i] = sarifResult{Level: d.Severity, Message: sarifMessage{d.Message}}
+		if d.File != "" {
+			location := sarifLocation{sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{d.File},
+			}}
+			if d.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{d.Line, d.Column}
+			}
+			results[i].Locations = []sarifLocation{location}
+		}
+	}
+
+	return &sarifRoot{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs: []sarifRun{{
+			Tool: sarifTool{sarifDriver{
+				Name:           "genqlient",
+				InformationURI: "https://github.com/Khan/genqlient",
+			}},
+			Results: results,
+		}},
+	}
+}
@@ -0,0 +1,36 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+func TestFormatDiagnostics(t *testing.T) {
+	_, err := Generate(&Config{
+		Schema:      []string{filepath.Join(errorsDir, "schema.graphql")},
+		Operations:  []string{filepath.Join(errorsDir, "MultipleErrors.go")},
+		Package:     "test",
+		Generated:   os.DevNull,
+		ContextType: "context.Context",
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	warnings := []error{
+		errorf(nil, "warning: unable to identify current package-path\n"),
+	}
+	diagnostics := newDiagnostics(warnings, err)
+
+	for _, format := range []string{"json", "sarif"} {
+		t.Run(format, func(t *testing.T) {
+			output, err := formatDiagnostics(format, diagnostics)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.Cupaloy.SnapshotT(t, string(output))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go/scanner"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -18,9 +19,15 @@ type errorPos struct {
 	line, col int
 }
 
-func (pos *errorPos) String() string {
+// resolve returns the filename and line of the error, mapping positions of
+// operations embedded in Go files back to the Go file.
+func (pos *errorPos) resolve() (filename string, line int) {
 	filename, lineOffset := splitFilename(pos.filename)
-	line := lineOffset + pos.line
+	return filename, lineOffset + pos.line
+}
+
+func (pos *errorPos) String() string {
+	filename, line := pos.resolve()
 	if line != 0 {
 		return fmt.Sprintf("%v:%v", filename, line)
 	} else {
@@ -28,6 +35,12 @@ func (pos *errorPos) String() string {
 	}
 }
 
+// positionString formats the given position as in error messages, for
+// referring to a second position in a message.
+func positionString(pos *ast.Position) string {
+	return (&errorPos{filename: pos.Src.Name, line: pos.Line, col: pos.Column}).String()
+}
+
 type genqlientError struct {
 	pos     *errorPos
 	msg     string
@@ -78,6 +91,19 @@ func errorf(pos *ast.Position, msg string, args ...interface{}) error {
 		}
 	}
 
+	// If we're wrapping several errors, wrap each of them, so that each keeps
+	// its own position.
+	var wrappedList errorList
+	if errors.As(wrapped, &wrappedList) {
+		errs := make([]error, len(wrappedList))
+		for i, err := range wrappedList {
+			elementArgs := append([]interface{}(nil), args...)
+			elementArgs[wrapIndex] = err
+			errs[i] = errorf(pos, msg, elementArgs...)
+		}
+		return newErrorList(errs...)
+	}
+
 	var wrappedGenqlient *genqlientError
 	isGenqlient := errors.As(wrapped, &wrappedGenqlient)
 	var wrappedGraphQL *gqlerror.Error
@@ -134,6 +160,96 @@ func errorf(pos *ast.Position, msg string, args ...interface{}) error {
 	}
 }
 
+// errorList is a list of errors, which genqlient returns when it finds
+// several problems at once, for example in several operations.  Each error
+// is typically a *genqlientError, with its own position; see newErrorList.
+type errorList []error
+
+func (errs errorList) Error() string {
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		texts[i] = err.Error()
+	}
+	return strings.Join(texts, "\n")
+}
+
+func (errs errorList) Unwrap() []error {
+	return errs
+}
+
+// newErrorList combines the given errors (any of which may be nil, or
+// themselves lists) into one, deduplicated and sorted by position.  It
+// returns nil if there were no errors, and the error itself if there was just
+// one.
+func newErrorList(errs ...error) error {
+	var flattened errorList
+	var flatten func(err error)
+	flatten = func(err error) {
+		var list errorList
+		if errors.As(err, &list) {
+			for _, err := range list {
+				flatten(err)
+			}
+		} else if err != nil {
+			flattened = append(flattened, err)
+		}
+	}
+	for _, err := range errs {
+		flatten(err)
+	}
+
+	deduped := sortAndDedupeErrors(flattened)
+	switch len(deduped) {
+	case 0:
+		return nil
+	case 1:
+		return deduped[0]
+	default:
+		return deduped
+	}
+}
+
+// sortAndDedupeErrors sorts the given errors by position, and removes any
+// with the same text as an earlier one.
+func sortAndDedupeErrors(errs []error) errorList {
+	sorted := append(errorList(nil), errs...)
+	// Errors without a position sort first, since they're likely to be the
+	// most general problems.
+	sortKey := func(err error) (filename string, line, col int) {
+		var genqlientErr *genqlientError
+		if errors.As(err, &genqlientErr) && genqlientErr.pos != nil {
+			filename, line = genqlientErr.pos.resolve()
+			return filename, line, genqlientErr.pos.col
+		}
+		return "", 0, 0
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		iFilename, iLine, iCol := sortKey(sorted[i])
+		jFilename, jLine, jCol := sortKey(sorted[j])
+		if iFilename != jFilename {
+			return iFilename < jFilename
+		}
+		if iLine != jLine {
+			return iLine < jLine
+		}
+		if iCol != jCol {
+			return iCol < jCol
+		}
+		return sorted[i].Error() < sorted[j].Error()
+	})
+
+	var deduped errorList
+	seen := make(map[string]bool, len(sorted))
+	for _, err := range sorted {
+		if text := err.Error(); !seen[text] {
+			seen[text] = true
+			deduped = append(deduped, err)
+		}
+	}
+
+	return deduped
+}
+
 // goSourceError processes the error(s) returned by go tooling (gofmt, etc.)
 // into a nice error message.
 //
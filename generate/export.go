@@ -0,0 +1,86 @@
+package generate
+
+// This file implements export_operations, which writes the exact operations
+// genqlient will send to the server, in one of several manifest formats (see
+// Config.ExportOperationsFormat).
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// operationHash returns the identifier of an operation with the given body in
+// persisted-operation manifests: the hex-encoded SHA-256 hash of the body, as
+// used by Apollo's automatic persisted queries and most other systems.
+func operationHash(body string) string {
+	hash := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(hash[:])
+}
+
+// exportedOperations is the genqlient (default) format: all the details we
+// have about each operation.
+type exportedOperations struct {
+	Operations []*operation `json:"operations"`
+}
+
+// apolloManifest is the format of Apollo's persisted-query manifests, as
+// generated by @apollo/generate-persisted-query-manifest and consumed by
+// GraphOS and the Apollo Router.
+type apolloManifest struct {
+	Format     string                    `json:"format"`
+	Version    int                       `json:"version"`
+	Operations []apolloManifestOperation `json:"operations"`
+}
+
+type apolloManifestOperation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+// exportOperations returns the content of the export_operations file for the
+// given operations, in the given format.
+func exportOperations(format string, operations []*operation) ([]byte, error) {
+	var value interface{}
+	switch format {
+	case "", "genqlient":
+		value = exportedOperations{Operations: operations}
+	case "apollo":
+		manifest := apolloManifest{
+			Format:     "apollo-persisted-query-manifest",
+			Version:    1,
+			Operations: make([]apolloManifestOperation, len(operations)),
+		}
+		for i, op := range operations {
+			manifest.Operations[i] = apolloManifestOperation{
+				ID:   op.Hash,
+				Name: op.Name,
+				Type: string(op.Type),
+				Body: op.Body,
+			}
+		}
+		value = manifest
+	case "relay":
+		// Relay's persisted-query format is just a map from ID to body.
+		// (Go sorts the keys, so the output is deterministic.)
+		bodies := make(map[string]string, len(operations))
+		for _, op := range operations {
+			bodies[op.Hash] = op.Body
+		}
+		value = bodies
+	default:
+		return nil, errorf(nil, "invalid export_operations_format %v", format)
+	}
+
+	// We use MarshalIndent so that the file is human-readable and slightly
+	// more likely to be git-mergeable (if you check it in).  In general it's
+	// never going to be used anywhere where space is an issue -- it doesn't
+	// go in your binary or anything.
+	b, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, errorf(nil, "unable to export queries: %v", err)
+	}
+	return b, nil
+}
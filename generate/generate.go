@@ -6,7 +6,6 @@ package generate
 
 import (
 	"bytes"
-	"encoding/json"
 	"go/format"
 	"io"
 	"sort"
@@ -28,14 +27,20 @@ type generator struct {
 	Operations []*operation
 	// The types needed for these operations.
 	typeMap map[string]goType
+	// Where each name in typeMap came from (see claimTypeName).
+	typeNameSources map[string]typeNameSource
+	// Unbound scalars we've warned about (see defaultBinding).  This is
+	// shared by all the generators in a run, so that we warn about each
+	// scalar only once even if several projects use it.
+	unknownScalars map[string]bool
 	// Imports needed for these operations, path -> alias and alias -> true
 	imports     map[string]string
 	usedAliases map[string]bool
 	// True if we've already written out the imports (in which case they can't
 	// be modified).
 	importsLocked bool
-	// Cache of loaded templates.
-	templateCache map[string]*template.Template
+	// The templates, loaded by loadTemplates.
+	templates *template.Template
 	// Schema we are generating code against
 	schema *ast.Schema
 	// Named fragments (map by name), so we can look them up from spreads.
@@ -43,6 +48,9 @@ type generator struct {
 	// ast.FragmentSpread.Definition, but for some reason it doesn't seem to be
 	// set consistently, even post-validation.
 	fragments map[string]*ast.FragmentDefinition
+	// Named fragments which are spread somewhere with @include or @skip (see
+	// findConditionalFragments).
+	conditionalFragments map[string]bool
 }
 
 // JSON tags in operation are for ExportOperations (see Config for details).
@@ -55,6 +63,9 @@ type operation struct {
 	Doc string `json:"-"`
 	// The body of the operation to send.
 	Body string `json:"query"`
+	// The hex-encoded SHA-256 hash of Body, which identifies the operation
+	// in persisted-operation manifests.
+	Hash string `json:"sha256Hash"`
 	// The type of the argument to the operation, which we use both internally
 	// and to construct the arguments.  We do it this way so we can use the
 	// machinery we have for handling (and, specifically, json-marshaling)
@@ -62,49 +73,43 @@ type operation struct {
 	Input *goStructType `json:"-"`
 	// The type-name for the operation's response type.
 	ResponseName string `json:"-"`
+	// True if the operation uses @defer or @stream, in which case we also
+	// generate a function to receive its incremental payloads.
+	Incremental bool `json:"-"`
 	// The original filename from which we got this query.
 	SourceFilename string `json:"sourceLocation"`
 	// The config within which we are generating code.
 	Config *Config `json:"-"`
 }
 
-type exportedOperations struct {
-	Operations []*operation `json:"operations"`
-}
-
 func newGenerator(
 	config *Config,
 	schema *ast.Schema,
-	fragments ast.FragmentDefinitionList,
+	document *ast.QueryDocument,
+	unknownScalars map[string]bool,
 ) *generator {
 	g := generator{
-		Config:        config,
-		typeMap:       map[string]goType{},
-		imports:       map[string]string{},
-		usedAliases:   map[string]bool{},
-		templateCache: map[string]*template.Template{},
-		schema:        schema,
-		fragments:     make(map[string]*ast.FragmentDefinition, len(fragments)),
+		Config:          config,
+		typeMap:         map[string]goType{},
+		typeNameSources: map[string]typeNameSource{},
+		unknownScalars:  unknownScalars,
+		imports:         map[string]string{},
+		usedAliases:     map[string]bool{},
+		schema:          schema,
+		fragments:       make(map[string]*ast.FragmentDefinition, len(document.Fragments)),
 	}
 
-	for _, fragment := range fragments {
+	for _, fragment := range document.Fragments {
 		g.fragments[fragment.Name] = fragment
 	}
+	g.conditionalFragments = findConditionalFragments(document)
 
 	return &g
 }
 
-func (g *generator) WriteTypes(w io.Writer) error {
-	names := make([]string, 0, len(g.typeMap))
-	for name := range g.typeMap {
-		names = append(names, name)
-	}
-	// Sort alphabetically by type-name.  Sorting somehow deterministically is
-	// important to ensure generated code is deterministic.  Alphabetical is
-	// nice because it's easy, and in the current naming scheme, it's even
-	// vaguely aligned to the structure of the queries.
-	sort.Strings(names)
-
+// WriteTypes writes the definitions of the types with the given names (keys
+// of g.typeMap), in order.
+func (g *generator) WriteTypes(w io.Writer, names []string) error {
 	for _, name := range names {
 		err := g.typeMap[name].WriteDefinition(w, g)
 		if err != nil {
@@ -152,6 +157,64 @@ func (g *generator) usedFragments(op *ast.OperationDefinition) ast.FragmentDefin
 	return retval
 }
 
+// findConditionalFragments returns the names of the named fragments which
+// may be omitted from the response wherever they are spread, because the
+// spread has @include or @skip (or is in an inline fragment which does, or at
+// the top level of a fragment which is itself conditional).  Their fields are
+// treated as optional (see convertNamedFragment), since a fragment's type is
+// shared by all of its spreads.
+func findConditionalFragments(document *ast.QueryDocument) map[string]bool {
+	conditional := map[string]bool{}
+	var visit func(selectionSet ast.SelectionSet, inConditional bool)
+	visit = func(selectionSet ast.SelectionSet, inConditional bool) {
+		for _, selection := range selectionSet {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				// The field's own selections are not conditional (see
+				// convertField).
+				visit(selection.SelectionSet, false)
+			case *ast.InlineFragment:
+				visit(selection.SelectionSet,
+					inConditional || isConditional(selection.Directives))
+			case *ast.FragmentSpread:
+				if inConditional || isConditional(selection.Directives) {
+					conditional[selection.Name] = true
+				}
+			}
+		}
+	}
+
+	for _, op := range document.Operations {
+		visit(op.SelectionSet, false)
+	}
+	// A fragment spread at the top level of a conditional fragment is itself
+	// conditional, so we walk the fragments until we find no new conditional
+	// ones.  (There are no cycles among fragments, so this terminates.)
+	for {
+		found := len(conditional)
+		for _, fragment := range document.Fragments {
+			visit(fragment.SelectionSet, conditional[fragment.Name])
+		}
+		if len(conditional) == found {
+			return conditional
+		}
+	}
+}
+
+// usesIncrementalDelivery returns true if the given document (an operation
+// and the fragments it uses) uses @defer or @stream.
+func (g *generator) usesIncrementalDelivery(doc *ast.QueryDocument) bool {
+	incremental := false
+	var observers validator.Events
+	observers.OnDirective(func(_ *validator.Walker, directive *ast.Directive) {
+		if directive.Name == "defer" || directive.Name == "stream" {
+			incremental = true
+		}
+	})
+	validator.Walk(g.schema, doc, &observers)
+	return incremental
+}
+
 // Preprocess each query to make any changes that genqlient needs.
 //
 // At present, the only change is that we add __typename, if not already
@@ -295,16 +358,19 @@ func (g *generator) addOperation(op *ast.OperationDefinition) error {
 		sourceFilename = sourceFilename[:i]
 	}
 
+	// The newline just makes it format a little nicer.  We add it here
+	// rather than in the template so exported operations (and their hashes)
+	// will match *exactly* what we send to the server.
+	body := "\n" + builder.String()
 	g.Operations = append(g.Operations, &operation{
-		Type: op.Operation,
-		Name: op.Name,
-		Doc:  docComment,
-		// The newline just makes it format a little nicer.  We add it here
-		// rather than in the template so exported operations will match
-		// *exactly* what we send to the server.
-		Body:           "\n" + builder.String(),
+		Type:           op.Operation,
+		Name:           op.Name,
+		Doc:            docComment,
+		Body:           body,
+		Hash:           operationHash(body),
 		Input:          inputType,
 		ResponseName:   responseType.Reference(),
+		Incremental:    g.usesIncrementalDelivery(queryDoc),
 		SourceFilename: sourceFilename,
 		Config:         g.Config, // for the convenience of the template
 	})
@@ -312,63 +378,22 @@ func (g *generator) addOperation(op *ast.OperationDefinition) error {
 	return nil
 }
 
-// Generate is the main programmatic entrypoint to genqlient, and generates and
-// returns Go source code based on the given configuration.
-//
-// See [Config] for more on creating a configuration.  The return value is a
-// map from filename to the generated file-content (e.g. Go source).  Callers
-// who don't want to manage reading and writing the files should call [Main].
-func Generate(config *Config) (map[string][]byte, error) {
-	// Step 1: Read in the schema and operations from the files defined by the
-	// config (and validate the operations against the schema).  This is all
-	// defined in parse.go.
-	schema, err := getSchema(config.Schema)
-	if err != nil {
-		return nil, err
-	}
-
-	document, err := getAndValidateQueries(config.baseDir, config.Operations, schema)
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO(benkraft): we could also allow this, and generate an empty file
-	// with just the package-name, if it turns out to be more convenient that
-	// way.  (As-is, we generate a broken file, with just (unused) imports.)
-	if len(document.Operations) == 0 {
-		// Hard to have a position when there are no operations :(
-		return nil, errorf(nil,
-			"no queries found, looked in: %v (configure this in genqlient.yaml)",
-			strings.Join(config.Operations, ", "))
-	}
-
-	// Step 2: For each operation and fragment, convert it into data structures
-	// representing Go types (defined in types.go).  The bulk of this logic is
-	// in convert.go, and it additionally updates g.typeMap to include all the
-	// types it needs.
-	g := newGenerator(config, schema, document.Fragments)
-	for _, op := range document.Operations {
-		if err = g.addOperation(op); err != nil {
-			return nil, err
-		}
-	}
+// writeFile writes out the Go source for the given file.
+func (g *generator) writeFile(file *outputFile) ([]byte, error) {
+	// Each file gets its own import-clause; we may have locked the imports
+	// while writing the last file.  (The clause may include imports only
+	// used by other files, but goimports will clean those up.)
+	g.importsLocked = false
 
-	// Step 3: Glue it all together!
-	//
-	// First, write the types (from g.typeMap) and operations to a temporary
-	// buffer, since they affect what imports we'll put in the header.
+	// First, write the types and operations to a temporary buffer, since they
+	// affect what imports we'll put in the header.
 	var bodyBuf bytes.Buffer
-	err = g.WriteTypes(&bodyBuf)
+	err := g.WriteTypes(&bodyBuf, file.typeNames)
 	if err != nil {
 		return nil, err
 	}
 
-	// Sort operations to guarantee a stable order
-	sort.Slice(g.Operations, func(i, j int) bool {
-		return g.Operations[i].Name < g.Operations[j].Name
-	})
-
-	for _, operation := range g.Operations {
+	for _, operation := range file.operations {
 		err = g.render("operation.go.tmpl", &bodyBuf, operation)
 		if err != nil {
 			return nil, err
@@ -406,26 +431,173 @@ func Generate(config *Config) (map[string][]byte, error) {
 	if err != nil {
 		return nil, goSourceError("gofmt", unformatted, err)
 	}
-	importsed, err := imports.Process(config.Generated, formatted, nil)
+	importsed, err := imports.Process(file.filename, formatted, nil)
 	if err != nil {
 		return nil, goSourceError("goimports", formatted, err)
 	}
+	return importsed, nil
+}
+
+// Generate is the main programmatic entrypoint to genqlient, and generates and
+// returns Go source code based on the given configuration.
+//
+// See [Config] for more on creating a configuration.  The return value is a
+// map from filename to the generated file-content (e.g. Go source).  Callers
+// who don't want to manage reading and writing the files should call [Main].
+//
+// If the config has projects, Generate generates code for each of them,
+// parsing the schema only once.
+//
+// If there are problems with several operations, the returned error
+// describes all of them, one per line, sorted by file and line.  It wraps
+// each of the underlying errors, for use with [errors.Is] and [errors.As].
+func Generate(config *Config) (map[string][]byte, error) {
+	// Step 1: Read in the schema and operations from the files defined by the
+	// config (and validate the operations against the schema).  This is all
+	// defined in parse.go.
+	schema, err := getSchema(config.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return generateWithSchema(config, schema)
+}
+
+// generateWithSchema is the implementation of Generate, once we have parsed
+// the schema.
+func generateWithSchema(config *Config, schema *ast.Schema) (map[string][]byte, error) {
+	unknownScalars := map[string]bool{}
+	if len(config.Projects) == 0 {
+		return generateProject(config, schema, unknownScalars)
+	}
+
+	retval := map[string][]byte{}
+	var errs []error
+	for _, project := range config.Projects {
+		generated, err := generateProject(project, schema, unknownScalars)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for filename, content := range generated {
+			if _, ok := retval[filename]; ok {
+				return nil, errorf(nil,
+					"multiple projects generate %v; each project must "+
+						"write to a separate generated (and export_operations) file",
+					filename)
+			}
+			retval[filename] = content
+		}
+	}
+	if err := newErrorList(errs...); err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
+// generateProject generates the code for a single project (or the whole
+// config, if it has no projects), once we have parsed its schema.
+// unknownScalars is shared by all the projects in the run (see generator).
+func generateProject(
+	config *Config,
+	schema *ast.Schema,
+	unknownScalars map[string]bool,
+) (map[string][]byte, error) {
+	g, err := convertProject(config, schema, unknownScalars)
+	if err != nil {
+		return nil, err
+	}
 
-	retval := map[string][]byte{
-		config.Generated: importsed,
+	// Step 3: Glue it all together!
+	//
+	// Sort operations to guarantee a stable order.
+	sort.Slice(g.Operations, func(i, j int) bool {
+		return g.Operations[i].Name < g.Operations[j].Name
+	})
+
+	// Then, decide which types and operations go in which file (usually
+	// there's just the one, see split.go), and write each.
+	files, err := g.splitOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	retval := make(map[string][]byte, len(files)+1)
+	for _, file := range files {
+		retval[file.filename], err = g.writeFile(file)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if config.ExportOperations != "" {
-		// We use MarshalIndent so that the file is human-readable and
-		// slightly more likely to be git-mergeable (if you check it in).  In
-		// general it's never going to be used anywhere where space is an
-		// issue -- it doesn't go in your binary or anything.
-		retval[config.ExportOperations], err = json.MarshalIndent(
-			exportedOperations{Operations: g.Operations}, "", "  ")
+		retval[config.ExportOperations], err = exportOperations(
+			config.ExportOperationsFormat, g.Operations)
 		if err != nil {
-			return nil, errorf(nil, "unable to export queries: %v", err)
+			return nil, err
 		}
 	}
 
 	return retval, nil
 }
+
+// convertProject reads the operations of a single project, validates them
+// against the schema, and converts them to Go types (steps 1 and 2 of
+// Generate), returning the generator with the results.
+func convertProject(
+	config *Config,
+	schema *ast.Schema,
+	unknownScalars map[string]bool,
+) (*generator, error) {
+	document, err := getAndValidateQueries(config.baseDir, config.Operations, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(benkraft): we could also allow this, and generate an empty file
+	// with just the package-name, if it turns out to be more convenient that
+	// way.  (As-is, we generate a broken file, with just (unused) imports.)
+	if len(document.Operations) == 0 {
+		// Hard to have a position when there are no operations :(
+		return nil, errorf(nil,
+			"no queries found, looked in: %v (configure this in genqlient.yaml)",
+			strings.Join(config.Operations, ", "))
+	}
+
+	// Before converting, report any uses of deprecated schema elements.
+	if config.DeprecatedUsage != "ignore" {
+		usages := findDeprecatedUsages(schema, document)
+		if config.DeprecatedUsage == "error" {
+			if err = newErrorList(usages...); err != nil {
+				return nil, err
+			}
+		}
+		for _, usage := range usages {
+			warn(errorf(nil, "warning: %v", usage))
+		}
+	}
+
+	// Step 2: For each operation and fragment, convert it into data structures
+	// representing Go types (defined in types.go).  The bulk of this logic is
+	// in convert.go, and it additionally updates g.typeMap to include all the
+	// types it needs.
+	g := newGenerator(config, schema, document, unknownScalars)
+	// Load the templates up front, so that any problems with the user's
+	// overrides are reported before we get too far.
+	if err = g.loadTemplates(); err != nil {
+		return nil, err
+	}
+	// We keep going after an error, so as to report all the problems at once
+	// (for example, if a schema change breaks several operations).
+	var errs []error
+	for _, op := range document.Operations {
+		errs = append(errs, g.addOperation(op))
+	}
+	if err = newErrorList(errs...); err != nil {
+		return nil, err
+	}
+	// If requested, share identical types between operations (see dedupe.go).
+	if config.DedupeTypes {
+		g.dedupeTypes()
+	}
+	return g, nil
+}
@@ -16,8 +16,9 @@ import (
 )
 
 const (
-	dataDir   = "testdata/queries"
-	errorsDir = "testdata/errors"
+	dataDir             = "testdata/queries"
+	errorsDir           = "testdata/errors"
+	invalidTemplatesDir = "testdata/invalidTemplates"
 )
 
 // buildGoFile returns an error if the given Go code is not valid.
@@ -169,6 +170,17 @@ func TestGenerateWithConfig(t *testing.T) {
 		{"ExportOperations", "", nil, &Config{
 			ExportOperations: "operations.json",
 		}},
+		{"DedupeTypes", "", []string{"DedupeTypes.graphql"}, &Config{
+			DedupeTypes: true,
+		}},
+		{"ExportOperationsApollo", "", []string{"SimpleQuery.graphql", "SimpleMutation.graphql"}, &Config{
+			ExportOperations:       "operations.json",
+			ExportOperationsFormat: "apollo",
+		}},
+		{"ExportOperationsRelay", "", []string{"SimpleQuery.graphql", "SimpleMutation.graphql"}, &Config{
+			ExportOperations:       "operations.json",
+			ExportOperationsFormat: "relay",
+		}},
 		{"CustomContext", "", nil, &Config{
 			ContextType: "github.com/Khan/genqlient/internal/testutil.MyContext",
 		}},
@@ -215,6 +227,9 @@ func TestGenerateWithConfig(t *testing.T) {
 			ClientGetter: "github.com/Khan/genqlient/internal/testutil.GetClientFromNowhere",
 			ContextType:  "-",
 		}},
+		{"ClientGetterIncremental", "", []string{"Defer.graphql"}, &Config{
+			ClientGetter: "github.com/Khan/genqlient/internal/testutil.GetClientFromContext",
+		}},
 		{"Extensions", "", nil, &Config{
 			Extensions: true,
 		}},
@@ -229,10 +244,27 @@ func TestGenerateWithConfig(t *testing.T) {
 		}, &Config{
 			Optional: "pointer",
 		}},
+		{"OptionalPointerIncludeSkip", "", []string{"IncludeSkip.graphql"}, &Config{
+			Optional: "pointer",
+		}},
+		{"OptionalGenericIncludeSkip", "", []string{"IncludeSkip.graphql"}, &Config{
+			Optional:            "generic",
+			OptionalGenericType: "github.com/Khan/genqlient/internal/testutil.Option",
+		}},
 		{"OptionalGeneric", "", []string{"ListInput.graphql", "QueryWithSlices.graphql"}, &Config{
 			Optional:            "generic",
 			OptionalGenericType: "github.com/Khan/genqlient/internal/testutil.Option",
 		}},
+		{"OptionalOmittable", "", []string{"InputObject.graphql", "ListInput.graphql", "QueryWithSlices.graphql", "IncludeSkip.graphql"}, &Config{
+			Optional: "omittable",
+			Bindings: map[string]*TypeBinding{
+				"Date": {
+					Type:        "time.Time",
+					Marshaler:   "github.com/Khan/genqlient/internal/testutil.MarshalDate",
+					Unmarshaler: "github.com/Khan/genqlient/internal/testutil.UnmarshalDate",
+				},
+			},
+		}},
 		{"EnumRawCasingAll", "", []string{"QueryWithEnums.graphql"}, &Config{
 			Casing: Casing{
 				AllEnums: CasingRaw,
@@ -282,6 +314,26 @@ func TestGenerateWithConfig(t *testing.T) {
 				Flatten: true,
 			},
 		},
+		{
+			"UnknownImplementations", "", []string{"ComplexNamedFragments.graphql"}, &Config{
+				UnknownImplementations: true,
+			},
+		},
+		{
+			"UnknownEnumValuesMap", "", []string{"QueryWithEnums.graphql"}, &Config{
+				UnknownEnumValues: "map",
+			},
+		},
+		{
+			"UnknownEnumValuesReject", "", []string{"QueryWithEnums.graphql"}, &Config{
+				UnknownEnumValues: "reject",
+			},
+		},
+		{
+			"Templates", "", []string{"SimpleQuery.graphql", "SimpleInput.graphql"}, &Config{
+				Templates: []string{"templates/overrides.tmpl"},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -393,3 +445,262 @@ func TestGenerateErrors(t *testing.T) {
 		})
 	}
 }
+
+// buildGoFiles is like buildGoFile, but builds several files as a package.
+func buildGoFiles(namePrefix string, files map[string][]byte) error {
+	dir, err := os.MkdirTemp("./testdata/tmp", namePrefix+"_*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	for filename, content := range files {
+		err = os.WriteFile(filepath.Join(dir, filepath.Base(filename)), content, 0o644)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd := exec.Command("go", "build", "./"+dir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("generated code does not compile: %w", err)
+	}
+	return nil
+}
+
+// TestGenerateSplitOutput tests the split_output option, which (unlike the
+// options in TestGenerateWithConfig) only does anything interesting when
+// generating code for several operations files at once.
+func TestGenerateSplitOutput(t *testing.T) {
+	operations := []string{
+		"ComplexNamedFragments.graphql",
+		"QueryWithEnums.graphql",
+		"SimpleInput.graphql",
+		"SimpleQuery.graphql",
+	}
+
+	for _, mode := range []string{"file", "operation"} {
+		t.Run(mode, func(t *testing.T) {
+			config := &Config{SplitOutput: mode}
+			err := config.ValidateAndFillDefaults(dataDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			config.Schema = []string{filepath.Join(dataDir, "schema.graphql")}
+			for _, operationFile := range operations {
+				config.Operations = append(config.Operations,
+					filepath.Join(dataDir, operationFile))
+			}
+
+			generated, err := Generate(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for filename, content := range generated {
+				t.Run(filename, func(t *testing.T) {
+					testutil.Cupaloy.SnapshotT(t, string(content))
+				})
+			}
+
+			t.Run("Build", func(t *testing.T) {
+				if testing.Short() {
+					t.Skip("skipping build due to -short")
+				}
+
+				err := buildGoFiles("SplitOutput", generated)
+				if err != nil {
+					t.Error(err)
+				}
+			})
+		})
+	}
+}
+
+// TestGenerateProjects tests generating code for several projects from a
+// single config.
+func TestGenerateProjects(t *testing.T) {
+	config, err := ReadAndValidateConfig("testdata/projects/genqlient.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := Generate(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for filename, content := range generated {
+		t.Run(filename, func(t *testing.T) {
+			testutil.Cupaloy.SnapshotT(t, string(content))
+		})
+
+		t.Run(filename+"/Build", func(t *testing.T) {
+			if testing.Short() {
+				t.Skip("skipping build due to -short")
+			}
+
+			err := buildGoFile(filepath.Base(filepath.Dir(filename)), content)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// TestGenerateTemplateErrors tests that we reject invalid template overrides,
+// using each file in testdata/invalidTemplates as the templates option.
+func TestGenerateTemplateErrors(t *testing.T) {
+	testAllSnapshots(t, invalidTemplatesDir, func(t *testing.T, filename string) {
+		_, err := Generate(&Config{
+			Schema:      []string{filepath.Join(dataDir, "schema.graphql")},
+			Operations:  []string{filepath.Join(dataDir, "SimpleQuery.graphql")},
+			Package:     "test",
+			Generated:   os.DevNull,
+			ContextType: "context.Context",
+			Templates:   []string{filename},
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		testutil.Cupaloy.SnapshotT(t, err.Error())
+	})
+}
+
+// captureWarnings replaces warn, for the rest of the test, with a function
+// which records the warnings, and returns the recorded warnings.
+func captureWarnings(t *testing.T) *[]string {
+	var warnings []string
+	defaultWarn := warn
+	warn = func(err error) { warnings = append(warnings, err.Error()) }
+	t.Cleanup(func() { warn = defaultWarn })
+	return &warnings
+}
+
+// testGenerateWithWarnings generates code for the given config, and checks
+// the generated code, and the warnings, against snapshots, and that the code
+// builds.
+func testGenerateWithWarnings(t *testing.T, name string, config *Config) {
+	warnings := captureWarnings(t)
+	generated, err := Generate(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Generated", func(t *testing.T) {
+		testutil.Cupaloy.SnapshotT(t, string(generated[config.Generated]))
+	})
+	t.Run("Warnings", func(t *testing.T) {
+		testutil.Cupaloy.SnapshotT(t, strings.Join(*warnings, "\n"))
+	})
+	t.Run("Build", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("skipping build due to -short")
+		}
+		if err := buildGoFile(name, generated[config.Generated]); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// TestGenerateDeprecated tests the handling of deprecated schema elements:
+// the generated code should mark them deprecated, and their uses should be
+// warnings (or errors, if so configured).
+func TestGenerateDeprecated(t *testing.T) {
+	dir := filepath.Join("testdata", "deprecated")
+	config := func(deprecatedUsage string) *Config {
+		return &Config{
+			Schema:          []string{filepath.Join(dir, "schema.graphql")},
+			Operations:      []string{filepath.Join(dir, "operations.graphql")},
+			Package:         "test",
+			Generated:       "generated.go",
+			ContextType:     "-",
+			DeprecatedUsage: deprecatedUsage,
+		}
+	}
+
+	testGenerateWithWarnings(t, "Deprecated", config("warn"))
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := Generate(config("error"))
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		testutil.Cupaloy.SnapshotT(t, err.Error())
+	})
+
+	t.Run("Ignore", func(t *testing.T) {
+		warnings := captureWarnings(t)
+		_, err := Generate(config("ignore"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(*warnings) > 0 {
+			t.Errorf("got warnings: %v", *warnings)
+		}
+	})
+}
+
+// TestGenerateDefaultBindings tests the default_bindings option: common
+// scalars should get the default bindings (unless bound explicitly), and
+// other scalars should be json.RawMessage, with a warning.
+func TestGenerateDefaultBindings(t *testing.T) {
+	dir := filepath.Join("testdata", "defaultbindings")
+	config := func() *Config {
+		return &Config{
+			Schema:          []string{filepath.Join(dir, "schema.graphql")},
+			Operations:      []string{filepath.Join(dir, "operations.graphql")},
+			Package:         "test",
+			Generated:       "generated.go",
+			ContextType:     "-",
+			DefaultBindings: true,
+			Bindings: map[string]*TypeBinding{
+				"Cursor": {Type: "string"},
+				"JSON":   {Type: "map[string]interface{}"},
+			},
+		}
+	}
+
+	testGenerateWithWarnings(t, "DefaultBindings", config())
+
+	t.Run("Projects", func(t *testing.T) {
+		// Each unknown scalar is warned about once, even if several projects
+		// use it.
+		first, second := config(), config()
+		first.Generated = filepath.Join("first", "generated.go")
+		second.Generated = filepath.Join("second", "generated.go")
+		warnings := captureWarnings(t)
+		_, err := Generate(&Config{
+			Schema:   first.Schema,
+			Projects: []*Config{first, second},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seen := map[string]bool{}
+		for _, warning := range *warnings {
+			if seen[warning] {
+				t.Errorf("got warning twice: %v", warning)
+			}
+			seen[warning] = true
+		}
+		if len(*warnings) == 0 {
+			t.Error("got no warnings")
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		disabled := config()
+		disabled.DefaultBindings = false
+		_, err := Generate(disabled)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		testutil.Cupaloy.SnapshotT(t, err.Error())
+	})
+}
@@ -11,18 +11,30 @@ import (
 // Represents the genqlient directive, described in detail in
 // docs/genqlient_directive.graphql.
 type genqlientDirective struct {
-	pos       *ast.Position
-	Omitempty *bool
-	Pointer   *bool
-	Struct    *bool
-	Flatten   *bool
-	Bind      string
-	TypeName  string
-	Alias     string
+	pos                    *ast.Position
+	Omitempty              *bool
+	Pointer                *bool
+	Struct                 *bool
+	Flatten                *bool
+	UnknownImplementations *bool
+	Optional               *bool
+	Bind                   string
+	TypeName               string
+	Alias                  string
 	// FieldDirectives contains the directives to be
 	// applied to specific fields via the "for" option.
 	// Map from type-name -> field-name -> directive.
 	FieldDirectives map[string]map[string]*genqlientDirective
+	// Whether flatten was set on this node itself, rather than inherited
+	// from the operation or genqlient.yaml; we only flatten a field whose
+	// selection is a single field (rather than fragment-spread) if so.
+	localFlatten bool
+	// Whether the fields to which this (operation or fragment) directive
+	// applies may be omitted from the response, because they are in an
+	// inline fragment with @include or @skip, or a named fragment spread with
+	// them.  This is set only on the copies of the directive that
+	// convertInlineFragment and convertNamedFragment pass down.
+	conditional bool
 }
 
 func newGenqlientDirective(pos *ast.Position) *genqlientDirective {
@@ -47,6 +59,12 @@ func (dir *genqlientDirective) argsString() string {
 	if dir.Flatten != nil {
 		parts = append(parts, fmt.Sprintf("flatten: %v", *dir.Flatten))
 	}
+	if dir.UnknownImplementations != nil {
+		parts = append(parts, fmt.Sprintf("unknown_implementations: %v", *dir.UnknownImplementations))
+	}
+	if dir.Optional != nil {
+		parts = append(parts, fmt.Sprintf("optional: %v", *dir.Optional))
+	}
 	if dir.Bind != "" {
 		parts = append(parts, fmt.Sprintf("bind: %v", dir.Bind))
 	}
@@ -76,6 +94,9 @@ func (dir *genqlientDirective) GetPointer() bool     { return dir.Pointer != nil
 func (dir *genqlientDirective) PointerIsFalse() bool { return dir.Pointer != nil && !*dir.Pointer }
 func (dir *genqlientDirective) GetStruct() bool      { return dir.Struct != nil && *dir.Struct }
 func (dir *genqlientDirective) GetFlatten() bool     { return dir.Flatten != nil && *dir.Flatten }
+func (dir *genqlientDirective) GetUnknownImplementations() bool {
+	return dir.UnknownImplementations != nil && *dir.UnknownImplementations
+}
 
 func setBool(optionName string, dst **bool, v *ast.Value, pos *ast.Position) error {
 	if *dst != nil {
@@ -170,6 +191,10 @@ func (dir *genqlientDirective) add(graphQLDirective *ast.Directive, pos *ast.Pos
 			err = setBool("struct", &dir.Struct, arg.Value, pos)
 		case "flatten":
 			err = setBool("flatten", &dir.Flatten, arg.Value, pos)
+		case "unknown_implementations":
+			err = setBool("unknown_implementations", &dir.UnknownImplementations, arg.Value, pos)
+		case "optional":
+			err = setBool("optional", &dir.Optional, arg.Value, pos)
 		case "bind":
 			err = setString("bind", &dir.Bind, arg.Value, pos)
 		case "typename":
@@ -211,12 +236,13 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 					fieldName, typeName)
 			}
 
-			// All options except struct and flatten potentially apply.  (I
-			// mean in theory you could apply them here, but since they require
-			// per-use validation, it would be a bit tricky, and the use case
-			// is not clear.)
-			if fieldDir.Struct != nil || fieldDir.Flatten != nil {
-				return errorf(fieldDir.pos, "struct and flatten can't be used via for")
+			// All options except struct, flatten, unknown_implementations,
+			// and optional potentially apply.  (I mean in theory you could
+			// apply them here, but since they require per-use validation, it
+			// would be a bit tricky, and the use case is not clear.)
+			if fieldDir.Struct != nil || fieldDir.Flatten != nil ||
+				fieldDir.UnknownImplementations != nil || fieldDir.Optional != nil {
+				return errorf(fieldDir.pos, "struct, flatten, unknown_implementations, and optional can't be used via for")
 			}
 
 			if fieldDir.TypeName != "" && fieldDir.Bind != "" && fieldDir.Bind != "-" {
@@ -231,6 +257,10 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "bind may not be applied to the entire operation")
 		}
 
+		if dir.Optional != nil {
+			return errorf(dir.pos, "optional is only applicable to fields, not the entire operation")
+		}
+
 		// Anything else is valid on the entire operation; it will just apply
 		// to whatever it is relevant to.
 		return nil
@@ -244,6 +274,10 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "struct is only applicable to fields, not fragment-definitions")
 		}
 
+		if dir.Optional != nil {
+			return errorf(dir.pos, "optional is only applicable to fields, not fragment-definitions")
+		}
+
 		// Like operations, anything else will just apply to the entire
 		// fragment.
 		return nil
@@ -260,6 +294,14 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			return errorf(dir.pos, "flatten is only applicable to fields, not variable-definitions")
 		}
 
+		if dir.UnknownImplementations != nil {
+			return errorf(dir.pos, "unknown_implementations is only applicable to fields, not variable-definitions")
+		}
+
+		if dir.Optional != nil {
+			return errorf(dir.pos, "optional is only applicable to fields, not variable-definitions")
+		}
+
 		if len(dir.FieldDirectives) > 0 {
 			return errorf(dir.pos, "for is only applicable to operations and arguments")
 		}
@@ -287,6 +329,12 @@ func (dir *genqlientDirective) validate(node interface{}, schema *ast.Schema) er
 			}
 		}
 
+		if dir.GetUnknownImplementations() {
+			if err := validateUnknownImplementationsOption(typ, node.SelectionSet, dir.pos); err != nil {
+				return err
+			}
+		}
+
 		if len(dir.FieldDirectives) > 0 {
 			return errorf(dir.pos, "for is only applicable to operations and arguments")
 		}
@@ -329,6 +377,41 @@ func validateStructOption(
 	return nil
 }
 
+func validateUnknownImplementationsOption(
+	typ *ast.Definition,
+	selectionSet ast.SelectionSet,
+	pos *ast.Position,
+) error {
+	if typ.Kind != ast.Interface && typ.Kind != ast.Union {
+		return errorf(pos, "unknown_implementations is only applicable to interface- or union-typed fields")
+	}
+
+	// Fragment-spreads on the interface itself become embedded interfaces,
+	// which can't know about the unknown implementation.  (Inline fragments,
+	// and spreads of fragments on particular implementations, are fine:
+	// they just don't apply to unknown types.)
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Alias == "raw" || selection.Alias == "Raw" {
+				return errorf(pos, "unknown_implementations is not allowed for types with a field named raw")
+			}
+		case *ast.FragmentSpread:
+			if fragmentMatches(typ, selection.Definition.Definition) {
+				return errorf(pos, "unknown_implementations is not allowed for types with fragment-spreads of the interface type")
+			}
+		case *ast.InlineFragment:
+			if selection.ObjectDefinition != nil && fragmentMatches(typ, selection.ObjectDefinition) {
+				err := validateUnknownImplementationsOption(typ, selection.SelectionSet, pos)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func validateFlattenOption(
 	typ *ast.Definition,
 	selectionSet ast.SelectionSet,
@@ -347,10 +430,10 @@ func validateFlattenOption(
 			if selection.Name == "__typename" && selection.Position == nil {
 				continue
 			}
-			// Type-wise, it's no harder to implement flatten for fields, but
-			// it requires new logic in UnmarshalJSON.  We can add that if it
-			// proves useful relative to its complexity.
-			return -1, errorf(pos, "flatten is not yet supported for fields (only fragment spreads)")
+			if index != -1 {
+				return -1, errorf(pos, "flatten is not allowed for fields with multiple selections")
+			}
+			index = i
 
 		case *ast.InlineFragment:
 			// Inline fragments aren't allowed. In principle there's nothing
@@ -378,6 +461,10 @@ func validateFlattenOption(
 			index = i
 		}
 	}
+	if index == -1 {
+		// (This can only happen if the selection was just __typename.)
+		return -1, errorf(pos, "flatten is not allowed for fields with no selections")
+	}
 	return index, nil
 }
 
@@ -440,9 +527,12 @@ func (dir *genqlientDirective) mergeOperationDirective(
 	// directive wins over the operation directive.
 	fillDefaultBool(&dir.Omitempty, forField.Omitempty, operationDirective.Omitempty)
 	fillDefaultBool(&dir.Pointer, forField.Pointer, operationDirective.Pointer)
-	// struct and flatten aren't settable via "for".
+	// struct, flatten, and unknown_implementations aren't settable via
+	// "for".
 	fillDefaultBool(&dir.Struct, operationDirective.Struct)
 	fillDefaultBool(&dir.Flatten, operationDirective.Flatten)
+	fillDefaultBool(&dir.UnknownImplementations, operationDirective.UnknownImplementations)
+	// optional isn't settable on the operation (it would be too broad).
 	fillDefaultString(&dir.Bind, forField.Bind, operationDirective.Bind)
 	// typename isn't settable on the operation (when set there it replies to
 	// the response-type).
@@ -505,14 +595,18 @@ func (g *generator) parsePrecedingComment(
 		}
 	}
 
+	directive.localFlatten = directive.GetFlatten()
 	if queryOptions == nil {
 		// We are parsing the directive on the entire operation or fragment;
 		// apply any project-wide defaults from genqlient.yaml.  (Per-node
 		// options always win, since fillDefault is a no-op if already set.)
+		t := true
 		if g.Config != nil && g.Config.Flatten {
-			t := true
 			fillDefaultBool(&directive.Flatten, &t)
 		}
+		if g.Config != nil && g.Config.UnknownImplementations {
+			fillDefaultBool(&directive.UnknownImplementations, &t)
+		}
 	} else {
 		// If we are part of an operation/fragment, merge its options in.
 		directive.mergeOperationDirective(node, parentIfInputField, queryOptions)
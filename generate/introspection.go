@@ -0,0 +1,649 @@
+package generate
+
+// This file converts the result of an introspection query, such as many
+// GraphQL APIs publish in lieu of SDL, into SDL, which we can then parse like
+// any other schema.  It also implements the introspect subcommand, which
+// runs such a query.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// The following types are the JSON representation of an introspection
+// result, per the GraphQL spec.  Fields are pointers where we need to
+// distinguish missing from empty, in order to detect incomplete results.
+type (
+	introspectionResult struct {
+		Data *struct {
+			Schema *introspectionSchema `json:"__schema"`
+		} `json:"data,omitempty"`
+		Schema *introspectionSchema `json:"__schema"`
+	}
+
+	introspectionSchema struct {
+		QueryType        *introspectionTypeRef     `json:"queryType"`
+		MutationType     *introspectionTypeRef     `json:"mutationType"`
+		SubscriptionType *introspectionTypeRef     `json:"subscriptionType"`
+		Types            []*introspectionType      `json:"types"`
+		Directives       []*introspectionDirective `json:"directives"`
+	}
+
+	introspectionType struct {
+		Kind           string                     `json:"kind"`
+		Name           string                     `json:"name"`
+		Description    string                     `json:"description"`
+		SpecifiedByURL string                     `json:"specifiedByURL"`
+		Fields         []*introspectionField      `json:"fields"`
+		InputFields    []*introspectionInputValue `json:"inputFields"`
+		Interfaces     []*introspectionTypeRef    `json:"interfaces"`
+		EnumValues     []*introspectionEnumValue  `json:"enumValues"`
+		PossibleTypes  []*introspectionTypeRef    `json:"possibleTypes"`
+	}
+
+	introspectionField struct {
+		Name              string                     `json:"name"`
+		Description       string                     `json:"description"`
+		Args              []*introspectionInputValue `json:"args"`
+		Type              *introspectionTypeRef      `json:"type"`
+		IsDeprecated      bool                       `json:"isDeprecated"`
+		DeprecationReason *string                    `json:"deprecationReason"`
+	}
+
+	introspectionInputValue struct {
+		Name              string                `json:"name"`
+		Description       string                `json:"description"`
+		Type              *introspectionTypeRef `json:"type"`
+		DefaultValue      *string               `json:"defaultValue"`
+		IsDeprecated      bool                  `json:"isDeprecated"`
+		DeprecationReason *string               `json:"deprecationReason"`
+	}
+
+	introspectionEnumValue struct {
+		Name              string  `json:"name"`
+		Description       string  `json:"description"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	}
+
+	introspectionTypeRef struct {
+		Kind   string                `json:"kind"`
+		Name   string                `json:"name"`
+		OfType *introspectionTypeRef `json:"ofType"`
+	}
+
+	introspectionDirective struct {
+		Name         string                     `json:"name"`
+		Description  string                     `json:"description"`
+		Locations    []string                   `json:"locations"`
+		Args         []*introspectionInputValue `json:"args"`
+		IsRepeatable bool                       `json:"isRepeatable"`
+	}
+)
+
+// builtinSchemaNames returns the names of the types and directives which
+// getSchema adds to every schema, which we therefore omit from the SDL.
+func builtinSchemaNames() (types, directives map[string]bool, err error) {
+	prelude, graphqlError := parser.ParseSchema(validator.Prelude)
+	if graphqlError != nil {
+		return nil, nil, fmt.Errorf("invalid prelude (probably a gqlparser bug): %w", graphqlError)
+	}
+	types = map[string]bool{}
+	for _, def := range prelude.Definitions {
+		types[def.Name] = true
+	}
+	directives = map[string]bool{"stream": true} // see incrementalDirectives
+	for _, dir := range prelude.Directives {
+		directives[dir.Name] = true
+	}
+	return types, directives, nil
+}
+
+// introspectionJSONToSDL parses the given introspection result, with or
+// without the {"data": ...} wrapper, and converts it to SDL.
+func introspectionJSONToSDL(text []byte) (string, error) {
+	var result introspectionResult
+	err := json.Unmarshal(text, &result)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+	schema := result.Schema
+	if schema == nil && result.Data != nil {
+		schema = result.Data.Schema
+	}
+	if schema == nil {
+		return "", fmt.Errorf(`expected an introspection result, with a "__schema" ` +
+			`key at the top level or under "data"`)
+	}
+	return introspectionToSDL(schema)
+}
+
+// introspectionToSDL converts the given introspection result to SDL.
+//
+// The types and directives are sorted by name, so that the output is
+// deterministic; fields and the like are kept in the order the server
+// returned them.
+func introspectionToSDL(schema *introspectionSchema) (string, error) {
+	if schema.QueryType == nil || schema.QueryType.Name == "" {
+		return "", fmt.Errorf("introspection result has no queryType")
+	}
+	if len(schema.Types) == 0 {
+		return "", fmt.Errorf("introspection result has no types")
+	}
+
+	builtinTypes, builtinDirectives, err := builtinSchemaNames()
+	if err != nil {
+		return "", err
+	}
+
+	types := make(map[string]*introspectionType, len(schema.Types))
+	for _, typ := range schema.Types {
+		if typ == nil || typ.Name == "" || typ.Kind == "" {
+			return "", fmt.Errorf("introspection result has a type with no name or kind")
+		}
+		types[typ.Name] = typ
+	}
+
+	w := &sdlWriter{types: types}
+	w.printf("schema {\n")
+	w.printf("  query: %s\n", schema.QueryType.Name)
+	if schema.MutationType != nil && schema.MutationType.Name != "" {
+		w.printf("  mutation: %s\n", schema.MutationType.Name)
+	}
+	if schema.SubscriptionType != nil && schema.SubscriptionType.Name != "" {
+		w.printf("  subscription: %s\n", schema.SubscriptionType.Name)
+	}
+	w.printf("}\n")
+
+	sortedTypes := make([]*introspectionType, 0, len(schema.Types))
+	for _, typ := range schema.Types {
+		if !builtinTypes[typ.Name] && !strings.HasPrefix(typ.Name, "__") {
+			sortedTypes = append(sortedTypes, typ)
+		}
+	}
+	sort.Slice(sortedTypes, func(i, j int) bool {
+		return sortedTypes[i].Name < sortedTypes[j].Name
+	})
+	for _, typ := range sortedTypes {
+		w.printf("\n")
+		w.writeType(typ)
+	}
+
+	sortedDirectives := make([]*introspectionDirective, 0, len(schema.Directives))
+	for _, dir := range schema.Directives {
+		if dir == nil || dir.Name == "" {
+			return "", fmt.Errorf("introspection result has a directive with no name")
+		}
+		if !builtinDirectives[dir.Name] {
+			sortedDirectives = append(sortedDirectives, dir)
+		}
+	}
+	sort.Slice(sortedDirectives, func(i, j int) bool {
+		return sortedDirectives[i].Name < sortedDirectives[j].Name
+	})
+	for _, dir := range sortedDirectives {
+		w.printf("\n")
+		w.writeDirective(dir)
+	}
+
+	if w.err != nil {
+		return "", w.err
+	}
+	return w.buf.String(), nil
+}
+
+// sdlWriter writes SDL for the types of an introspection result.  It
+// records the first error it encounters in err, so callers need only check
+// at the end.
+type sdlWriter struct {
+	buf   bytes.Buffer
+	types map[string]*introspectionType
+	err   error
+}
+
+func (w *sdlWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&w.buf, format, args...)
+}
+
+func (w *sdlWriter) fail(format string, args ...interface{}) {
+	if w.err == nil {
+		w.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (w *sdlWriter) writeType(typ *introspectionType) {
+	w.writeDescription(typ.Description, "")
+	switch typ.Kind {
+	case "SCALAR":
+		w.printf("scalar %s", typ.Name)
+		if typ.SpecifiedByURL != "" {
+			w.printf(" @specifiedBy(url: %s)", graphQLString(typ.SpecifiedByURL))
+		}
+		w.printf("\n")
+	case "OBJECT", "INTERFACE":
+		if typ.Fields == nil {
+			w.fail("type %s has no fields; the introspection query must "+
+				"request fields(includeDeprecated: true)", typ.Name)
+			return
+		}
+		keyword := "type"
+		if typ.Kind == "INTERFACE" {
+			keyword = "interface"
+		}
+		w.printf("%s %s", keyword, typ.Name)
+		for i, iface := range typ.Interfaces {
+			if i == 0 {
+				w.printf(" implements ")
+			} else {
+				w.printf(" & ")
+			}
+			w.printf("%s", w.typeRef(iface, typ.Name))
+		}
+		w.printf(" {\n")
+		for _, field := range typ.Fields {
+			w.writeDescription(field.Description, "  ")
+			w.printf("  %s", field.Name)
+			w.writeArgs(field.Args, typ.Name+"."+field.Name)
+			w.printf(": %s", w.typeRef(field.Type, typ.Name+"."+field.Name))
+			w.writeDeprecated(field.IsDeprecated, field.DeprecationReason)
+			w.printf("\n")
+		}
+		w.printf("}\n")
+	case "UNION":
+		if typ.PossibleTypes == nil {
+			w.fail("union %s has no possibleTypes; the introspection query "+
+				"must request possibleTypes", typ.Name)
+			return
+		}
+		w.printf("union %s =", typ.Name)
+		for i, member := range typ.PossibleTypes {
+			if i > 0 {
+				w.printf(" |")
+			}
+			w.printf(" %s", w.typeRef(member, typ.Name))
+		}
+		w.printf("\n")
+	case "ENUM":
+		if typ.EnumValues == nil {
+			w.fail("enum %s has no enumValues; the introspection query must "+
+				"request enumValues(includeDeprecated: true)", typ.Name)
+			return
+		}
+		w.printf("enum %s {\n", typ.Name)
+		for _, value := range typ.EnumValues {
+			w.writeDescription(value.Description, "  ")
+			w.printf("  %s", value.Name)
+			w.writeDeprecated(value.IsDeprecated, value.DeprecationReason)
+			w.printf("\n")
+		}
+		w.printf("}\n")
+	case "INPUT_OBJECT":
+		if typ.InputFields == nil {
+			w.fail("input %s has no inputFields; the introspection query "+
+				"must request inputFields", typ.Name)
+			return
+		}
+		w.printf("input %s {\n", typ.Name)
+		for _, field := range typ.InputFields {
+			w.writeDescription(field.Description, "  ")
+			w.printf("  ")
+			w.writeInputValue(field, typ.Name)
+			w.printf("\n")
+		}
+		w.printf("}\n")
+	default:
+		w.fail("type %s has unknown kind %s", typ.Name, typ.Kind)
+	}
+}
+
+func (w *sdlWriter) writeDirective(dir *introspectionDirective) {
+	w.writeDescription(dir.Description, "")
+	w.printf("directive @%s", dir.Name)
+	w.writeArgs(dir.Args, "@"+dir.Name)
+	if dir.IsRepeatable {
+		w.printf(" repeatable")
+	}
+	if len(dir.Locations) == 0 {
+		w.fail("directive @%s has no locations", dir.Name)
+		return
+	}
+	w.printf(" on %s\n", strings.Join(dir.Locations, " | "))
+}
+
+func (w *sdlWriter) writeArgs(args []*introspectionInputValue, context string) {
+	if len(args) == 0 {
+		return
+	}
+	w.printf("(")
+	for i, arg := range args {
+		if i > 0 {
+			w.printf(", ")
+		}
+		if arg.Description != "" {
+			w.printf("%s ", graphQLString(arg.Description))
+		}
+		w.writeInputValue(arg, context)
+	}
+	w.printf(")")
+}
+
+func (w *sdlWriter) writeInputValue(value *introspectionInputValue, context string) {
+	w.printf("%s: %s", value.Name, w.typeRef(value.Type, context+"."+value.Name))
+	if value.DefaultValue != nil {
+		w.printf(" = %s", *value.DefaultValue)
+	}
+	w.writeDeprecated(value.IsDeprecated, value.DeprecationReason)
+}
+
+func (w *sdlWriter) writeDeprecated(isDeprecated bool, reason *string) {
+	if !isDeprecated {
+		return
+	}
+	w.printf(" @deprecated")
+	if reason != nil {
+		w.printf("(reason: %s)", graphQLString(*reason))
+	}
+}
+
+func (w *sdlWriter) writeDescription(description, indent string) {
+	if description == "" {
+		return
+	}
+	if !strings.Contains(description, "\n") {
+		w.printf("%s%s\n", indent, graphQLString(description))
+		return
+	}
+	w.printf("%s\"\"\"\n", indent)
+	for _, line := range strings.Split(description, "\n") {
+		if line == "" {
+			w.printf("\n")
+		} else {
+			w.printf("%s%s\n", indent, strings.ReplaceAll(line, `"""`, `\"""`))
+		}
+	}
+	w.printf("%s\"\"\"\n", indent)
+}
+
+// typeRef returns the SDL for the given type-reference, such as [String!]!,
+// which appears in context (e.g. MyType.myField).
+func (w *sdlWriter) typeRef(ref *introspectionTypeRef, context string) string {
+	switch {
+	case ref == nil:
+		w.fail("%s has no type", context)
+		return ""
+	case ref.Kind == "NON_NULL" || ref.Kind == "LIST":
+		if ref.OfType == nil {
+			w.fail("incomplete type for %s; the introspection query must "+
+				"request ofType to a greater depth", context)
+			return ""
+		}
+		elem := w.typeRef(ref.OfType, context)
+		if ref.Kind == "LIST" {
+			return "[" + elem + "]"
+		}
+		return elem + "!"
+	case ref.Name == "":
+		w.fail("%s has a type with no name", context)
+		return ""
+	case w.types[ref.Name] == nil:
+		w.fail("%s has type %s, which is not in the introspection result", context, ref.Name)
+		return ""
+	default:
+		return ref.Name
+	}
+}
+
+// graphQLString returns a GraphQL string literal with the given value.
+func graphQLString(s string) string {
+	// JSON string syntax is a subset of GraphQL's.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // can't fail for a string
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// introspectionQuery is the standard introspection query, which fetches
+// everything we need to reconstruct the schema.
+const introspectionQuery = `
+query IntrospectionQuery {
+	__schema {
+		queryType { name }
+		mutationType { name }
+		subscriptionType { name }
+		types { ...FullType }
+		directives {
+			name
+			description
+			locations
+			args { ...InputValue }
+			isRepeatable
+		}
+	}
+}
+
+fragment FullType on __Type {
+	kind
+	name
+	description
+	specifiedByURL
+	fields(includeDeprecated: true) {
+		name
+		description
+		args { ...InputValue }
+		type { ...TypeRef }
+		isDeprecated
+		deprecationReason
+	}
+	inputFields { ...InputValue }
+	interfaces { ...TypeRef }
+	enumValues(includeDeprecated: true) {
+		name
+		description
+		isDeprecated
+		deprecationReason
+	}
+	possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+	name
+	description
+	type { ...TypeRef }
+	defaultValue
+}
+
+fragment TypeRef on __Type {
+	kind
+	name
+	ofType {
+		kind
+		name
+		ofType {
+			kind
+			name
+			ofType {
+				kind
+				name
+				ofType {
+					kind
+					name
+					ofType {
+						kind
+						name
+						ofType {
+							kind
+							name
+							ofType {
+								kind
+								name
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+// legacyIntrospectionQuery is introspectionQuery without the fields added to
+// the spec in 2021 (specifiedByURL and isRepeatable), which older servers
+// reject.
+var legacyIntrospectionQuery = strings.NewReplacer(
+	"\tspecifiedByURL\n", "",
+	"\t\t\tisRepeatable\n", "",
+).Replace(introspectionQuery)
+
+// isValidationError returns true if the given error from the introspection
+// query, whose response data is data, is the server rejecting the query
+// itself: that is, a successful (200) response with errors and no data.
+// That's how a server which doesn't support the fields omitted from
+// legacyIntrospectionQuery rejects them; other errors (say, HTTP errors, or
+// errors from the server's resolvers) won't go away if we retry without them.
+func isValidationError(err error, data json.RawMessage) bool {
+	var errs gqlerror.List
+	return errors.As(err, &errs) && (len(data) == 0 || string(data) == "null")
+}
+
+// Introspect fetches the schema of a GraphQL server, using the given client,
+// via the standard introspection query.  (If the server predates the 2021
+// spec, and rejects the fields it added, we retry without them.)  It returns
+// the schema in the given format: "sdl" (the default), or "json" for the
+// introspection result itself.
+// Either may be used as the schema in genqlient.yaml.
+//
+// In either case, the types and directives are sorted by name, so that the
+// output is deterministic.
+func Introspect(ctx context.Context, client graphql.Client, format string) ([]byte, error) {
+	if format != "" && format != "sdl" && format != "json" {
+		return nil, errorf(nil, "format must be one of: 'sdl' (default) or 'json'")
+	}
+
+	var data json.RawMessage
+	err := client.MakeRequest(ctx,
+		&graphql.Request{OpName: "IntrospectionQuery", Query: introspectionQuery},
+		&graphql.Response{Data: &data})
+	if err != nil && isValidationError(err, data) {
+		// If the retry fails too, the server's problem is something else, so
+		// we report the original error, which is more likely to explain it.
+		data = nil
+		retryErr := client.MakeRequest(ctx,
+			&graphql.Request{OpName: "IntrospectionQuery", Query: legacyIntrospectionQuery},
+			&graphql.Response{Data: &data})
+		if retryErr == nil {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, errorf(nil, "introspection query failed: %v", err)
+	}
+
+	var result introspectionResult
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &result); err != nil {
+			return nil, errorf(nil, "invalid introspection result: %v", err)
+		}
+	}
+	if result.Schema == nil {
+		return nil, errorf(nil, "introspection query returned no schema")
+	}
+
+	if format != "json" {
+		sdl, sdlErr := introspectionToSDL(result.Schema)
+		if sdlErr != nil {
+			return nil, errorf(nil, "invalid introspection result: %v", sdlErr)
+		}
+		return []byte(sdl), nil
+	}
+
+	sort.Slice(result.Schema.Types, func(i, j int) bool {
+		return result.Schema.Types[i].Name < result.Schema.Types[j].Name
+	})
+	sort.Slice(result.Schema.Directives, func(i, j int) bool {
+		return result.Schema.Directives[i].Name < result.Schema.Directives[j].Name
+	})
+	text, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, errorf(nil, "unable to marshal introspection result: %v", err)
+	}
+	return append(text, '\n'), nil
+}
+
+// headerDoer is a graphql.Doer which adds the given headers to each request.
+type headerDoer struct {
+	header http.Header
+	doer   graphql.Doer
+}
+
+func (d *headerDoer) Do(req *http.Request) (*http.Response, error) {
+	for key, values := range d.header {
+		req.Header[key] = values
+	}
+	return d.doer.Do(req)
+}
+
+// introspectAndWrite is the implementation of the introspect subcommand.
+func introspectAndWrite(args *introspectArgs) error {
+	filename := args.Output
+	if filename == "" {
+		config, err := readConfig(args.Config)
+		if err != nil {
+			return err
+		}
+		if len(config.Schema) != 1 || strings.ContainsAny(config.Schema[0], "*?[{") {
+			return errorf(nil, "schema in genqlient.yaml must be a single "+
+				"filename (not a list or glob) to introspect into it; use "+
+				"--output to choose a file")
+		}
+		filename = config.Schema[0]
+	}
+
+	format := args.Format
+	if format == "" {
+		format = "sdl"
+		if filepath.Ext(filename) == ".json" {
+			format = "json"
+		}
+	}
+
+	header := http.Header{}
+	for _, h := range args.Headers {
+		key, value, ok := strings.Cut(h, ":")
+		if !ok {
+			return errorf(nil, "invalid header %q: must be of the form 'Name: value'", h)
+		}
+		header.Add(strings.TrimSpace(key), os.ExpandEnv(strings.TrimSpace(value)))
+	}
+
+	client := graphql.NewClient(args.Endpoint,
+		&headerDoer{header: header, doer: http.DefaultClient})
+	schema, err := Introspect(context.Background(), client, format)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filename), 0o755)
+	if err != nil {
+		return errorf(nil, "could not create parent directory for schema file %v: %v", filename, err)
+	}
+	err = os.WriteFile(filename, schema, 0o644)
+	if err != nil {
+		return errorf(nil, "could not write schema file %v: %v", filename, err)
+	}
+	return nil
+}
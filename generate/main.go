@@ -6,42 +6,58 @@
 package generate
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 
 	"github.com/alexflint/go-arg"
+	"github.com/pmezard/go-difflib/difflib"
 )
 
-// TODO(benkraft): Make this mockable for tests?
-func warn(err error) {
+// warn reports a warning to the user.  It's a variable so that
+// --format=json and --format=sarif can collect the warnings instead.
+var warn = func(err error) {
 	fmt.Println(err)
 }
 
-func readConfigGenerateAndWrite(configFilename string) error {
-	var config *Config
-	var err error
+// readConfig reads the config from the given filename, or if it's empty, from
+// the default locations.
+func readConfig(configFilename string) (*Config, error) {
 	if configFilename != "" {
-		config, err = ReadAndValidateConfig(configFilename)
-		if err != nil {
-			return err
-		}
-	} else {
-		config, err = ReadAndValidateConfigFromDefaultLocations()
-		if err != nil {
-			return err
-		}
+		return ReadAndValidateConfig(configFilename)
+	}
+	return ReadAndValidateConfigFromDefaultLocations()
+}
+
+func readConfigGenerateAndWrite(configFilename string) error {
+	config, err := readConfig(configFilename)
+	if err != nil {
+		return err
 	}
 
 	generated, err := Generate(config)
 	if err != nil {
 		return err
 	}
+	stale, err := staleGeneratedFiles(config, generated)
+	if err != nil {
+		return err
+	}
 
+	return writeGenerated(generated, stale)
+}
+
+// writeGenerated writes the given generated files, creating their parent
+// directories if needed, and deletes the given stale ones (see
+// staleGeneratedFiles).
+func writeGenerated(generated map[string][]byte, stale []string) error {
 	for filename, content := range generated {
-		err = os.MkdirAll(filepath.Dir(filename), 0o755)
+		err := os.MkdirAll(filepath.Dir(filename), 0o755)
 		if err != nil {
 			return errorf(nil,
 				"could not create parent directory for generated file %v: %v",
@@ -54,19 +70,169 @@ func readConfigGenerateAndWrite(configFilename string) error {
 				filename, err)
 		}
 	}
+	for _, filename := range stale {
+		err := os.Remove(filename)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return errorf(nil, "could not delete stale generated file %v: %v",
+				filename, err)
+		}
+	}
+	return nil
+}
+
+func readConfigGenerateAndCheck(configFilename string) error {
+	config, err := readConfig(configFilename)
+	if err != nil {
+		return err
+	}
+
+	generated, err := Generate(config)
+	if err != nil {
+		return err
+	}
+	stale, err := staleGeneratedFiles(config, generated)
+	if err != nil {
+		return err
+	}
+
+	return checkGenerated(generated, stale)
+}
+
+// watchAndWrite generates code, and then regenerates it whenever the config,
+// schema, operations, or package_bindings sources change, until interrupted.
+// Errors are printed, rather than returned, so that the user can fix them
+// and carry on.
+func watchAndWrite(configFilename string) error {
+	if configFilename == "" {
+		var err error
+		configFilename, err = findCfg()
+		if err != nil {
+			return errorf(nil, "unable to find genqlient.yaml: %v", err)
+		}
+	}
+
+	w := newWatcher(configFilename)
+	kind := watchConfig
+	for {
+		filenames, err := w.regenerate(kind)
+		if err != nil {
+			fmt.Println(err)
+		} else if len(filenames) > 0 {
+			fmt.Printf("genqlient: wrote %v\n", strings.Join(filenames, ", "))
+		}
+		fmt.Println("genqlient: watching for changes (press Ctrl-C to stop)")
+		kind = w.wait()
+	}
+}
+
+// checkGenerated compares the generated files to what's on disk, and returns
+// an error containing a diff of any that differ, or of any stale files (see
+// staleGeneratedFiles), which would be deleted.
+func checkGenerated(generated map[string][]byte, stale []string) error {
+	filenames := make([]string, 0, len(generated)+len(stale))
+	for filename := range generated {
+		filenames = append(filenames, filename)
+	}
+	filenames = append(filenames, stale...)
+	sort.Strings(filenames)
+
+	var diffs strings.Builder
+	for _, filename := range filenames {
+		oldName, newName := filename, filename
+		if _, ok := generated[filename]; !ok {
+			newName = "/dev/null"
+		}
+		existing, err := os.ReadFile(filename)
+		if errors.Is(err, fs.ErrNotExist) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return errorf(nil, "could not read generated file %v: %v",
+				filename, err)
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(string(existing)),
+			B:        splitLines(string(generated[filename])),
+			FromFile: oldName,
+			ToFile:   newName,
+			Context:  3,
+		})
+		if err != nil {
+			return errorf(nil, "could not diff generated file %v: %v",
+				filename, err)
+		}
+		diffs.WriteString(diff)
+	}
+
+	if diffs.Len() > 0 {
+		return errorf(nil, "%sgenerated code is out of date; "+
+			"run genqlient to update it", diffs.String())
+	}
 	return nil
 }
 
+// splitLines splits s into lines, each ending in a newline, as difflib
+// expects.  (Unlike difflib.SplitLines, it doesn't add an empty line at the
+// end of text which ends in a newline.)
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
 type cliArgs struct {
 	ConfigFilename string `arg:"positional" placeholder:"CONFIG" default:"" help:"path to genqlient configuration (default: genqlient.yaml in current or any parent directory)"`
+	Format         string `arg:"--format" default:"text" help:"format for errors and warnings: text, json (for scripts), or sarif (for code-scanning tools)"`
 	Init           bool   `arg:"--init" help:"write out and use a default config file"`
+	Check          bool   `arg:"--check" help:"check that generated code is up to date, printing a diff and exiting non-zero if not, instead of writing it"`
+	Watch          bool   `arg:"--watch" help:"regenerate whenever the schema, operations, or package_bindings sources change"`
 	Version        bool   `arg:"--version" help:"print version information"`
 }
 
+// introspectArgs are the arguments to the introspect subcommand.  (go-arg
+// doesn't allow subcommands alongside the positional CONFIG, so we parse
+// these separately.)
+type introspectArgs struct {
+	Endpoint string   `arg:"positional,required" placeholder:"ENDPOINT" help:"URL of the GraphQL server"`
+	Config   string   `arg:"--config" placeholder:"CONFIG" help:"path to genqlient configuration, whose schema to write (default: genqlient.yaml in current or any parent directory)"`
+	Output   string   `arg:"-o,--output" placeholder:"FILE" help:"file to write the schema to (default: the schema from genqlient configuration)"`
+	Format   string   `arg:"--format" help:"sdl or json (default: json if the file ends in .json, else sdl)"`
+	Headers  []string `arg:"-H,--header,separate" placeholder:"HEADER" help:"HTTP header to send, as 'Name: value'; $VARIABLES in the value are expanded from the environment"`
+}
+
+func (introspectArgs) Description() string {
+	return strings.TrimSpace(`
+Fetches the schema from a GraphQL server, via introspection, and writes it to
+the schema file from genqlient configuration.
+`)
+}
+
+// checkSchemaArgs are the arguments to the check-schema subcommand.
+type checkSchemaArgs struct {
+	Schema []string `arg:"positional,required" placeholder:"NEW_SCHEMA" help:"the new schema, as one or more files or globs (like schema in genqlient.yaml)"`
+	Config string   `arg:"--config" placeholder:"CONFIG" help:"path to genqlient configuration (default: genqlient.yaml in current or any parent directory)"`
+}
+
+func (checkSchemaArgs) Description() string {
+	return strings.TrimSpace(`
+Checks whether a new version of the schema would break the operations from
+genqlient configuration, or the code genqlient generated for them from the
+current schema: for example, removing fields, changing their types, or adding
+implementations of interfaces or unions.  Exits non-zero if so.
+`)
+}
+
 func (cliArgs) Description() string {
 	return strings.TrimSpace(`
 Generates GraphQL client code for a given schema and queries.
 See https://github.com/Khan/genqlient for full documentation.
+
+To fetch the schema from a server, run: genqlient introspect ENDPOINT
+(see genqlient introspect --help).  To check whether a new schema would break
+your operations, run: genqlient check-schema NEW_SCHEMA (see genqlient
+check-schema --help).
 `)
 }
 
@@ -119,6 +285,27 @@ func Main() {
 		}
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "introspect" {
+		var args introspectArgs
+		parser, err := arg.NewParser(arg.Config{Program: "genqlient introspect"}, &args)
+		exitIfError(err)
+		parser.MustParse(os.Args[2:])
+		err = introspectAndWrite(&args)
+		exitIfError(err)
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "check-schema" {
+		var args checkSchemaArgs
+		parser, err := arg.NewParser(arg.Config{Program: "genqlient check-schema"}, &args)
+		exitIfError(err)
+		parser.MustParse(os.Args[2:])
+		err = readConfigAndCheckSchema(&args)
+		exitIfError(err)
+		fmt.Println("genqlient: no breaking changes found")
+		return
+	}
+
 	var args cliArgs
 	arg.MustParse(&args)
 
@@ -127,6 +314,43 @@ func Main() {
 		return
 	}
 
+	switch args.Format {
+	case "", "text":
+	case "json", "sarif":
+		if args.Watch {
+			exitIfError(errorf(nil, "--format may not be used with --watch"))
+		}
+	default:
+		exitIfError(errorf(nil, "invalid --format %v; expected text, json, or sarif", args.Format))
+	}
+	if args.Check && (args.Init || args.Watch) {
+		exitIfError(errorf(nil, "--check may not be used with --init or --watch"))
+	}
+
+	if args.Format == "" || args.Format == "text" {
+		err := run(&args)
+		exitIfError(err)
+		return
+	}
+
+	var warnings []error
+	warn = func(err error) { warnings = append(warnings, err) }
+	err := run(&args)
+	output, formatErr := formatDiagnostics(args.Format, newDiagnostics(warnings, err))
+	exitIfError(formatErr)
+	fmt.Print(string(output))
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// run does whatever the command-line arguments ask for (other than printing
+// the version).
+func run(args *cliArgs) error {
+	if args.Check {
+		return readConfigGenerateAndCheck(args.ConfigFilename)
+	}
+
 	if args.Init {
 		filename := args.ConfigFilename
 		if filename == "" {
@@ -134,8 +358,12 @@ func Main() {
 		}
 
 		err := initConfig(filename)
-		exitIfError(err)
+		if err != nil {
+			return err
+		}
+	}
+	if args.Watch {
+		return watchAndWrite(args.ConfigFilename)
 	}
-	err := readConfigGenerateAndWrite(args.ConfigFilename)
-	exitIfError(err)
+	return readConfigGenerateAndWrite(args.ConfigFilename)
 }
@@ -0,0 +1,126 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckGenerated(t *testing.T) {
+	dir := t.TempDir()
+	upToDate := filepath.Join(dir, "uptodate.go")
+	stale := filepath.Join(dir, "stale.go")
+	missing := filepath.Join(dir, "missing.json")
+	for filename, content := range map[string]string{
+		upToDate: "package p\n",
+		stale:    "package p\n\nvar x = 1\n",
+	} {
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := checkGenerated(map[string][]byte{upToDate: []byte("package p\n")}, nil)
+	if err != nil {
+		t.Errorf("got error for up-to-date file: %v", err)
+	}
+
+	err = checkGenerated(map[string][]byte{
+		upToDate: []byte("package p\n"),
+		stale:    []byte("package p\n\nvar x = 2\n"),
+		missing:  []byte("{}\n"),
+	}, nil)
+	if err == nil {
+		t.Fatal("got no error for out-of-date files")
+	}
+	for _, want := range []string{
+		"--- /dev/null\n+++ " + missing + "\n@@ -0,0 +1 @@\n+{}\n",
+		"--- " + stale + "\n+++ " + stale + "\n@@ -1,3 +1,3 @@\n package p\n \n-var x = 1\n+var x = 2\n",
+		"generated code is out of date",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not contain %q:\n%v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), upToDate) {
+		t.Errorf("error mentions up-to-date file:\n%v", err)
+	}
+
+	content, err := os.ReadFile(stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "package p\n\nvar x = 1\n" {
+		t.Errorf("stale file was modified: %q", content)
+	}
+	if _, err := os.Stat(missing); err == nil {
+		t.Errorf("missing file was written")
+	}
+}
+
+// TestStaleGeneratedFiles tests that when an operations file is renamed,
+// with split_output, --check reports the file generated for its old name, and
+// generating deletes it.
+func TestStaleGeneratedFiles(t *testing.T) {
+	dir := t.TempDir()
+	configFilename := filepath.Join(dir, "genqlient.yaml")
+	handWritten := filepath.Join(dir, "generated", "client.go")
+	for filename, content := range map[string]string{
+		configFilename: "schema: schema.graphql\n" +
+			"operations: [queries/*.graphql]\n" +
+			"generated: generated/generated.go\n" +
+			"package: generated\n" +
+			"split_output: file\n",
+		filepath.Join(dir, "schema.graphql"):           "type Query { f: String }\n",
+		filepath.Join(dir, "queries", "users.graphql"): "query Q { f }\n",
+		handWritten: "package generated\n",
+	} {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	oldFile := filepath.Join(dir, "generated", "users_generated.go")
+	newFile := filepath.Join(dir, "generated", "people_generated.go")
+
+	if err := readConfigGenerateAndWrite(configFilename); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(oldFile); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Rename(filepath.Join(dir, "queries", "users.graphql"),
+		filepath.Join(dir, "queries", "people.graphql")); err != nil {
+		t.Fatal(err)
+	}
+
+	err := readConfigGenerateAndCheck(configFilename)
+	if err == nil {
+		t.Fatal("got no error for stale file")
+	}
+	if want := "--- " + oldFile + "\n+++ /dev/null\n"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error does not contain %q:\n%v", want, err)
+	}
+	if strings.Contains(err.Error(), handWritten) {
+		t.Errorf("error mentions hand-written file:\n%v", err)
+	}
+
+	if err := readConfigGenerateAndWrite(configFilename); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(oldFile); err == nil {
+		t.Errorf("stale file was not deleted")
+	}
+	for _, filename := range []string{newFile, handWritten} {
+		if _, err := os.Stat(filename); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := readConfigGenerateAndCheck(configFilename); err != nil {
+		t.Errorf("got error after regenerating: %v", err)
+	}
+}
@@ -79,12 +79,9 @@ package generate
 //			a { ... }   # type: BC
 //		}
 //   can collide.
-// All cases seem fairly rare in practice; eventually we'll likely allow users
-// the ability to specify their own names, which they could use to avoid this
-// (see [issue #12]).
-// TODO(benkraft): We should probably at least try to detect it and bail.
-//
-// [issue #12]: https://github.com/Khan/genqlient/issues/12
+// All cases seem fairly rare in practice.  We detect them (see
+// generator.claimTypeName) and report both fields involved, so that the user
+// can use the typename option to give one of them a different name.
 //
 // To implement all of the above, as we traverse the operation (and schema) in
 // convert.go, we keep track of a list of parts to prefix to our type-names.
@@ -115,11 +112,16 @@ import (
 type prefixList struct {
 	head string // the list goes back-to-front, so this is the *last* prefix
 	tail *prefixList
+	// The path of fields to this point, like "MyOperation.myField.mySubField"
+	// (using aliases), and the position of the last field, if any.  These
+	// are used to report conflicting type-names.
+	path string
+	pos  *ast.Position
 }
 
 // creates a new one-element list
 func newPrefixList(item string) *prefixList {
-	return &prefixList{head: item}
+	return &prefixList{head: item, path: item}
 }
 
 func joinPrefixList(prefix *prefixList) string {
@@ -151,7 +153,7 @@ func typeNameParts(prefix *prefixList, typeName string, algorithm CasingAlgorith
 		strings.HasSuffix(joinPrefixList(prefix), typeName) {
 		return prefix
 	}
-	return &prefixList{typeName, prefix}
+	return &prefixList{head: typeName, tail: prefix, path: prefix.path, pos: prefix.pos}
 }
 
 // Given a prefix-list, and a field, compute the next prefix-list, which will
@@ -161,7 +163,12 @@ func nextPrefix(prefix *prefixList, field *ast.Field, algorithm CasingAlgorithm)
 	prefix = typeNameParts(prefix, field.ObjectDefinition.Name, algorithm)
 	// Add the field (there's no shortening here, see top-of-file comment).
 	fieldAlias := ApplyCasing(field.Alias, algorithm, true)
-	prefix = &prefixList{fieldAlias, prefix}
+	prefix = &prefixList{
+		head: fieldAlias,
+		tail: prefix,
+		path: prefix.path + "." + field.Alias,
+		pos:  field.Position,
+	}
 	return prefix
 }
 
@@ -178,7 +185,7 @@ func makeTypeName(prefix *prefixList, typeName string, algorithm CasingAlgorithm
 // the implementations.
 func makeLongTypeName(prefix *prefixList, typeName string, algorithm CasingAlgorithm) string {
 	typeName = ApplyCasing(typeName, algorithm, true)
-	return joinPrefixList(&prefixList{typeName, prefix})
+	return joinPrefixList(&prefixList{head: typeName, tail: prefix})
 }
 
 func (casing *Casing) enumValueName(goTypeName string, enum *ast.Definition, val *ast.EnumValueDefinition) string {
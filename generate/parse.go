@@ -18,6 +18,15 @@ import (
 	_ "github.com/vektah/gqlparser/v2/validator/rules"
 )
 
+// incrementalDirectives are the definitions of the directives used for
+// incremental delivery, per the [incremental delivery RFC].
+//
+// [incremental delivery RFC]: https://github.com/graphql/graphql-spec/pull/742
+const incrementalDirectives = `
+directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
+directive @stream(if: Boolean = true, label: String, initialCount: Int = 0) on FIELD
+`
+
 func getSchema(globs StringList) (*ast.Schema, error) {
 	filenames, err := expandFilenames(globs)
 	if err != nil {
@@ -30,6 +39,13 @@ func getSchema(globs StringList) (*ast.Schema, error) {
 		if err != nil {
 			return nil, errorf(nil, "unreadable schema file %v: %v", filename, err)
 		}
+		if filepath.Ext(filename) == ".json" {
+			sdl, err := introspectionJSONToSDL(text)
+			if err != nil {
+				return nil, errorf(nil, "invalid introspection result in schema file %v: %v", filename, err)
+			}
+			text = []byte(sdl)
+		}
 		sources[i] = &ast.Source{Name: filename, Input: string(text)}
 	}
 
@@ -63,6 +79,20 @@ func getSchema(globs StringList) (*ast.Schema, error) {
 		document.Merge(preludeAST)
 	}
 
+	// The prelude includes @defer, but not (yet) @stream; and schemas with
+	// builtins may lack both.  Add whichever are missing, so that operations
+	// may use them.
+	incrementalAST, graphqlError := parser.ParseSchema(
+		&ast.Source{Name: "incremental.graphql", Input: incrementalDirectives, BuiltIn: true})
+	if graphqlError != nil {
+		return nil, errorf(nil, "invalid incremental directives (probably a genqlient bug): %v", graphqlError)
+	}
+	for _, directive := range incrementalAST.Directives {
+		if document.Directives.ForName(directive.Name) == nil {
+			document.Directives = append(document.Directives, directive)
+		}
+	}
+
 	schema, graphqlError := validator.ValidateSchemaDocument(document)
 	if graphqlError != nil {
 		return nil, errorf(nil, "invalid schema: %v", graphqlError)
@@ -80,7 +110,11 @@ func getAndValidateQueries(basedir string, filenames StringList, schema *ast.Sch
 	// Cf. gqlparser.LoadQuery
 	graphqlErrors := validator.Validate(schema, queryDoc)
 	if graphqlErrors != nil {
-		return nil, errorf(nil, "query-spec does not match schema: %v", graphqlErrors)
+		errs := make([]error, len(graphqlErrors))
+		for i, graphqlError := range graphqlErrors {
+			errs[i] = errorf(nil, "query-spec does not match schema: %v", graphqlError)
+		}
+		return nil, newErrorList(errs...)
 	}
 
 	return queryDoc, nil
@@ -130,17 +164,21 @@ func getQueries(basedir string, globs StringList) (*ast.QueryDocument, error) {
 		return nil, err
 	}
 
+	// We keep going after an error, so as to report all the problems at once.
+	var errs []error
 	for _, filename := range filenames {
 		text, err := os.ReadFile(filename)
 		if err != nil {
-			return nil, errorf(nil, "unreadable query-spec file %v: %v", filename, err)
+			errs = append(errs, errorf(nil, "unreadable query-spec file %v: %v", filename, err))
+			continue
 		}
 
 		switch filepath.Ext(filename) {
 		case ".graphql", ".graphqls", ".gql":
 			queryDoc, err := getQueriesFromString(string(text), basedir, filename)
 			if err != nil {
-				return nil, err
+				errs = append(errs, err)
+				continue
 			}
 
 			addQueryDoc(queryDoc)
@@ -148,7 +186,8 @@ func getQueries(basedir string, globs StringList) (*ast.QueryDocument, error) {
 		case ".go":
 			queryDocs, err := getQueriesFromGo(string(text), basedir, filename)
 			if err != nil {
-				return nil, err
+				errs = append(errs, err)
+				continue
 			}
 
 			for _, queryDoc := range queryDocs {
@@ -156,10 +195,13 @@ func getQueries(basedir string, globs StringList) (*ast.QueryDocument, error) {
 			}
 
 		default:
-			return nil, errorf(nil, "unknown file type: %v", filename)
+			errs = append(errs, errorf(nil, "unknown file type: %v", filename))
 		}
 	}
 
+	if err := newErrorList(errs...); err != nil {
+		return nil, err
+	}
 	return mergedQueryDoc, nil
 }
 
@@ -188,20 +230,17 @@ func getQueriesFromGo(text string, basedir, filename string) ([]*ast.QueryDocume
 	}
 
 	var retval []*ast.QueryDocument
+	var errs []error
 	goAst.Inspect(f, func(node goAst.Node) bool {
-		if err != nil {
-			return false // don't bother to recurse if something already failed
-		}
-
 		basicLit, ok := node.(*goAst.BasicLit)
 		if !ok || basicLit.Kind != goToken.STRING {
 			return true // recurse
 		}
 
-		var value string
-		value, err = strconv.Unquote(basicLit.Value)
+		value, err := strconv.Unquote(basicLit.Value)
 		if err != nil {
-			return false
+			errs = append(errs, errorf(nil, "invalid Go file %v: %v", filename, err))
+			return true
 		}
 
 		if !strings.HasPrefix(strings.TrimSpace(value), "# @genqlient") {
@@ -213,15 +252,15 @@ func getQueriesFromGo(text string, basedir, filename string) ([]*ast.QueryDocume
 		// our errors).
 		pos := fset.Position(basicLit.Pos())
 		fakeFilename := fmt.Sprintf("%v:%v", pos.Filename, pos.Line)
-		var query *ast.QueryDocument
-		query, err = getQueriesFromString(value, basedir, fakeFilename)
+		query, err := getQueriesFromString(value, basedir, fakeFilename)
 		if err != nil {
-			return false
+			errs = append(errs, err)
+			return true
 		}
 		retval = append(retval, query)
 
 		return true
 	})
 
-	return retval, err
+	return retval, newErrorList(errs...)
 }
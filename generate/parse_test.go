@@ -1,6 +1,11 @@
 package generate
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -8,12 +13,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
 )
 
 var (
-	parseDataDir       = "testdata/parsing"
-	parseErrorsDir     = "testdata/parsing-errors"
-	expandFilenamesDir = "testdata/expandFilenames"
+	parseDataDir           = "testdata/parsing"
+	parseErrorsDir         = "testdata/parsing-errors"
+	expandFilenamesDir     = "testdata/expandFilenames"
+	introspectionDir       = "testdata/introspection"
+	introspectionErrorsDir = "testdata/introspection-errors"
 )
 
 func sortQueries(queryDoc *ast.QueryDocument) {
@@ -137,3 +148,139 @@ func TestParseErrors(t *testing.T) {
 		})
 	}
 }
+
+func formatSchema(t *testing.T, filename string) string {
+	schema, err := getSchema([]string{filename})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var builder strings.Builder
+	formatter.NewFormatter(&builder).FormatSchema(schema)
+	return builder.String()
+}
+
+// TestIntrospectionSchema tests that reading a schema from an introspection
+// result produces the same schema as reading the equivalent SDL.
+func TestIntrospectionSchema(t *testing.T) {
+	assert.Equal(t,
+		formatSchema(t, filepath.Join(dataDir, "schema.graphql")),
+		formatSchema(t, filepath.Join(introspectionDir, "schema.json")))
+}
+
+// TestIntrospectionSDL is a snapshot test of the SDL we generate from an
+// introspection result, to check that we include the features which
+// TestIntrospectionSchema's schema lacks, such as deprecations.
+func TestIntrospectionSDL(t *testing.T) {
+	text, err := os.ReadFile(filepath.Join(introspectionDir, "Features.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdl, err := introspectionJSONToSDL(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.Cupaloy.SnapshotT(t, sdl)
+
+	// Make sure it's valid, too.
+	formatSchema(t, filepath.Join(introspectionDir, "Features.json"))
+}
+
+func TestIntrospectionErrors(t *testing.T) {
+	testAllSnapshots(t, introspectionErrorsDir, func(t *testing.T, filename string) {
+		_, err := getSchema([]string{filename})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		testutil.Cupaloy.SnapshotT(t, err.Error())
+	})
+}
+
+// TestIntrospectLegacyServer tests that Introspect retries without the fields
+// added to the spec in 2021 if the server rejects them.
+func TestIntrospectLegacyServer(t *testing.T) {
+	text, err := os.ReadFile(filepath.Join(introspectionDir, "schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := introspectionJSONToSDL(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const legacyMessage = `Cannot query field "specifiedByURL" on type "__Type".`
+	tests := []struct {
+		name string
+		// The response the server returns to queries which use
+		// specifiedByURL.
+		response map[string]interface{}
+		// Whether the server supports queries which don't; if not, it
+		// returns an error to those too.
+		legacy      bool
+		status      int
+		wantQueries int
+		// The error we expect, if any.
+		wantErr string
+	}{
+		{
+			"Legacy",
+			map[string]interface{}{"errors": []map[string]string{{"message": legacyMessage}}},
+			true, http.StatusOK, 2, "",
+		},
+		{
+			"OtherError",
+			map[string]interface{}{"errors": []map[string]string{{"message": "introspection is disabled"}}},
+			false, http.StatusOK, 2, "introspection is disabled",
+		},
+		{
+			"HTTPError",
+			map[string]interface{}{"errors": []map[string]string{{"message": legacyMessage}}},
+			true, http.StatusBadRequest, 1, "returned error 400",
+		},
+		{
+			"ErrorWithData",
+			map[string]interface{}{
+				"data":   map[string]interface{}{"__schema": nil},
+				"errors": []map[string]string{{"message": legacyMessage}},
+			},
+			true, http.StatusOK, 1, "specifiedByURL",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var queries []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req graphql.Request
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+				queries = append(queries, req.Query)
+				if !strings.Contains(req.Query, "specifiedByURL") {
+					if test.legacy {
+						_, _ = w.Write(text)
+					} else {
+						_, _ = w.Write([]byte(`{"errors": [{"message": "legacy query failed"}]}`))
+					}
+					return
+				}
+				resp, _ := json.Marshal(test.response)
+				w.WriteHeader(test.status)
+				_, _ = w.Write(resp)
+			}))
+			defer server.Close()
+
+			client := graphql.NewClient(server.URL, server.Client())
+			sdl, err := Introspect(context.Background(), client, "sdl")
+			assert.Len(t, queries, test.wantQueries)
+			if test.wantErr != "" {
+				// We report the error from the first query, not the retry.
+				assert.ErrorContains(t, err, test.wantErr)
+				assert.NotContains(t, err.Error(), "legacy query failed")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expected, string(sdl))
+			assert.NotContains(t, queries[1], "specifiedByURL")
+			assert.NotContains(t, queries[1], "isRepeatable")
+		})
+	}
+}
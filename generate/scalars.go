@@ -0,0 +1,66 @@
+package generate
+
+// This file implements the default_bindings option, which binds custom
+// scalars common to many schemas without any configuration.
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const scalarsPackage = "github.com/Khan/genqlient/graphql/scalars"
+
+// defaultBindings are the bindings used for scalars with these names when
+// default_bindings is set, unless genqlient.yaml has its own binding.
+//
+// Changing these changes the generated code for everyone who uses the
+// option, so we only include scalars whose meaning is nearly universal.
+var defaultBindings = map[string]*TypeBinding{
+	"DateTime": {
+		Type:        "time.Time",
+		Marshaler:   scalarsPackage + ".MarshalDateTime",
+		Unmarshaler: scalarsPackage + ".UnmarshalDateTime",
+	},
+	"Time": {
+		Type:        "time.Time",
+		Marshaler:   scalarsPackage + ".MarshalDateTime",
+		Unmarshaler: scalarsPackage + ".UnmarshalDateTime",
+	},
+	"Date": {
+		Type:        "time.Time",
+		Marshaler:   scalarsPackage + ".MarshalDate",
+		Unmarshaler: scalarsPackage + ".UnmarshalDate",
+	},
+	"JSON": {Type: "encoding/json.RawMessage"},
+	"UUID": {Type: "string"},
+	"Long": {Type: "int64"},
+	"BigInt": {
+		Type:        "math/big.Int",
+		Marshaler:   scalarsPackage + ".MarshalBigInt",
+		Unmarshaler: scalarsPackage + ".UnmarshalBigInt",
+	},
+}
+
+// unknownScalarBinding is the binding used, when default_bindings is set,
+// for scalars with neither an explicit nor a default binding.  Whatever the
+// scalar's JSON looks like, json.RawMessage can hold it.
+var unknownScalarBinding = &TypeBinding{Type: "encoding/json.RawMessage"}
+
+// defaultBinding returns the binding to use for the given custom scalar,
+// which has no binding in genqlient.yaml, when default_bindings is set; or
+// false if there is none (because the scalar is a builtin).
+func (g *generator) defaultBinding(def *ast.Definition) (*TypeBinding, bool) {
+	if builtinTypes[def.Name] != "" {
+		return nil, false
+	}
+	if binding, ok := defaultBindings[def.Name]; ok {
+		return binding, true
+	}
+
+	if !g.unknownScalars[def.Name] {
+		g.unknownScalars[def.Name] = true
+		warn(errorf(def.Position, "warning: unknown scalar %v; using "+
+			"json.RawMessage (add it to \"bindings\" in genqlient.yaml to "+
+			"use a different type)", def.Name))
+	}
+	return unknownScalarBinding, true
+}
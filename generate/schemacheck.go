@@ -0,0 +1,269 @@
+package generate
+
+// This file implements the check-schema subcommand, which reports how a new
+// version of the schema would break the configured operations, or the code
+// genqlient generated for them against the current schema.
+
+import (
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// readConfigAndCheckSchema is the implementation of the check-schema
+// subcommand.
+func readConfigAndCheckSchema(args *checkSchemaArgs) error {
+	config, err := readConfig(args.Config)
+	if err != nil {
+		return err
+	}
+	return checkSchema(config, args.Schema)
+}
+
+// checkSchema returns an error describing each way in which the schema from
+// newSchemaGlobs would break the operations in config, or the code generated
+// for them from the current schema; or nil if there are none.
+//
+// In particular, we report operations which are no longer valid, and
+// differences in the Go types generated for the two schemas which mean that
+// the code generated for the current schema can't handle the new schema's
+// responses, or sends inputs it won't accept.
+func checkSchema(config *Config, newSchemaGlobs StringList) error {
+	oldSchema, err := getSchema(config.Schema)
+	if err != nil {
+		return err
+	}
+	newSchema, err := getSchema(newSchemaGlobs)
+	if err != nil {
+		return errorf(nil, "invalid new schema: %v", err)
+	}
+
+	var errs []error
+	unknownScalars := map[string]bool{}
+	for _, project := range config.allProjects() {
+		// Uses of deprecated fields are reported by the ordinary run; here
+		// we're only interested in what breaks.
+		project := *project
+		project.DeprecatedUsage = "ignore"
+
+		oldGenerator, err := convertProject(&project, oldSchema, unknownScalars)
+		if err != nil {
+			return errorf(nil, "operations are invalid against the current "+
+				"schema (fix them first): %v", err)
+		}
+		newGenerator, err := convertProject(&project, newSchema, unknownScalars)
+		if err != nil {
+			errs = append(errs, errorf(nil, "breaks with new schema: %v", err))
+			continue
+		}
+		errs = append(errs, compareGeneratedTypes(oldGenerator, newGenerator)...)
+	}
+	return newErrorList(errs...)
+}
+
+// compareGeneratedTypes returns an error for each breaking difference
+// between the types generated for the two schemas.
+func compareGeneratedTypes(oldGenerator, newGenerator *generator) []error {
+	names := make([]string, 0, len(oldGenerator.typeMap))
+	for name := range oldGenerator.typeMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		oldType := oldGenerator.typeMap[name]
+		newType, ok := newGenerator.typeMap[name]
+		if !ok {
+			errs = append(errs, errorf(nil,
+				"breaks with new schema: type %s would no longer be generated", name))
+			continue
+		}
+
+		if goTypeKind(oldType) != goTypeKind(newType) {
+			errs = append(errs, errorf(nil,
+				"breaks with new schema: type %s would change from %s to %s",
+				name, goTypeKind(oldType), goTypeKind(newType)))
+			continue
+		}
+
+		switch oldType := oldType.(type) {
+		case *goStructType:
+			if newType, ok := newType.(*goStructType); ok {
+				errs = append(errs, compareStructTypes(oldType, newType)...)
+			}
+		case *goInterfaceType:
+			if newType, ok := newType.(*goInterfaceType); ok {
+				errs = append(errs, compareInterfaceTypes(oldType, newType)...)
+			}
+		case *goEnumType:
+			if newType, ok := newType.(*goEnumType); ok {
+				errs = append(errs, compareEnumTypes(oldType, newType)...)
+			}
+		}
+	}
+	return errs
+}
+
+func goTypeKind(typ goType) string {
+	switch typ.(type) {
+	case *goStructType:
+		return "struct"
+	case *goInterfaceType:
+		return "interface"
+	case *goEnumType:
+		return "enum"
+	default:
+		return typ.Reference()
+	}
+}
+
+func compareStructTypes(oldType, newType *goStructType) []error {
+	newFields := make(map[string]*goStructField, len(newType.Fields))
+	for _, field := range newType.Fields {
+		if !field.IsEmbedded() {
+			newFields[field.JSONName] = field
+		}
+	}
+
+	var errs []error
+	for _, oldField := range oldType.Fields {
+		if oldField.IsEmbedded() {
+			continue // the fragment's type is compared on its own
+		}
+		pos := fieldPosition(oldType.Selection, oldField.JSONName)
+		newField, ok := newFields[oldField.JSONName]
+		switch {
+		case !ok:
+			// For response types, the operation would be invalid, which we'll
+			// have reported already; but input types always send all their
+			// fields.
+			errs = append(errs, errorf(pos,
+				"breaks with new schema: field %s.%s would be removed",
+				oldType.GoName, oldField.GoName))
+		case !compatibleFieldTypes(oldField.GoType, newField.GoType, oldType.IsInput):
+			errs = append(errs, errorf(pos,
+				"breaks with new schema: field %s.%s would change type from %s to %s",
+				oldType.GoName, oldField.GoName,
+				oldField.GoType.Reference(), newField.GoType.Reference()))
+		}
+	}
+	return errs
+}
+
+// compatibleFieldTypes returns true if code which uses oldType for a field
+// can handle the values of a field of newType.  Apart from identical types,
+// that's the case if the field (or its list elements) became non-null in a
+// response type, where the old code just never sees a null, or nullable in an
+// input type, where the old code just never sends one.
+func compatibleFieldTypes(oldType, newType goType, isInput bool) bool {
+	if oldType.Reference() == newType.Reference() {
+		return true
+	}
+	oldSlice, oldIsSlice := oldType.(*goSliceType)
+	newSlice, newIsSlice := newType.(*goSliceType)
+	if oldIsSlice && newIsSlice {
+		return compatibleFieldTypes(oldSlice.Elem, newSlice.Elem, isInput)
+	}
+	if elem, ok := nullableElem(oldType); ok && !isInput {
+		return compatibleFieldTypes(elem, newType, isInput)
+	}
+	if elem, ok := nullableElem(newType); ok && isInput {
+		return compatibleFieldTypes(oldType, elem, isInput)
+	}
+	return false
+}
+
+// nullableElem returns the type wrapped by the given type, if it's one of
+// those we use for nullable values (per the optional option): a pointer or
+// a generic type.
+func nullableElem(typ goType) (goType, bool) {
+	switch typ := typ.(type) {
+	case *goPointerType:
+		return typ.Elem, true
+	case *goGenericType:
+		return typ.Elem, true
+	default:
+		return nil, false
+	}
+}
+
+func compareInterfaceTypes(oldType, newType *goInterfaceType) []error {
+	if oldType.Unknown != nil {
+		// The code for the current schema handles new implementations.
+		return nil
+	}
+
+	oldImplementations := make(map[string]bool, len(oldType.Implementations))
+	for _, impl := range oldType.Implementations {
+		oldImplementations[impl.GraphQLName] = true
+	}
+
+	var pos *ast.Position
+	if len(oldType.Selection) > 0 {
+		pos = oldType.Selection[0].GetPosition()
+	}
+	var errs []error
+	for _, impl := range newType.Implementations {
+		if !oldImplementations[impl.GraphQLName] {
+			errs = append(errs, errorf(pos,
+				"breaks with new schema: %s would have a new implementation %s, "+
+					"which the code generated for the current schema can't "+
+					"unmarshal (see the unknown_implementations option)",
+				oldType.GoName, impl.GraphQLName))
+		}
+	}
+	return errs
+}
+
+func compareEnumTypes(oldType, newType *goEnumType) []error {
+	oldValues := make(map[string]bool, len(oldType.Values))
+	for _, val := range oldType.Values {
+		oldValues[val.GraphQLName] = true
+	}
+	newValues := make(map[string]bool, len(newType.Values))
+	for _, val := range newType.Values {
+		newValues[val.GraphQLName] = true
+	}
+
+	var errs []error
+	for _, val := range oldType.Values {
+		if !newValues[val.GraphQLName] {
+			errs = append(errs, errorf(nil,
+				"breaks with new schema: enum %s would no longer have value %s",
+				oldType.GraphQLName, val.GraphQLName))
+		}
+	}
+	if oldType.UnknownValues == "reject" {
+		for _, val := range newType.Values {
+			if !oldValues[val.GraphQLName] {
+				errs = append(errs, errorf(nil,
+					"breaks with new schema: enum %s would have a new value %s, "+
+						"which the code generated for the current schema rejects "+
+						"(see the unknown_enum_values option)",
+					oldType.GraphQLName, val.GraphQLName))
+			}
+		}
+	}
+	return errs
+}
+
+// fieldPosition returns the position of the field with the given alias in the
+// given selection set (or its inline fragments, whose fields are included in
+// the implementation types of interfaces), or nil if there isn't one (for
+// example, for input types, which have no selection set).
+func fieldPosition(selectionSet ast.SelectionSet, alias string) *ast.Position {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Alias == alias {
+				return selection.Position
+			}
+		case *ast.InlineFragment:
+			if pos := fieldPosition(selection.SelectionSet, alias); pos != nil {
+				return pos
+			}
+		}
+	}
+	return nil
+}
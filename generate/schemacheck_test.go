@@ -0,0 +1,46 @@
+package generate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+func TestCheckSchema(t *testing.T) {
+	dir := filepath.Join("testdata", "checkSchema")
+	tests := []struct {
+		name      string
+		newSchema string
+		wantError bool
+	}{
+		{"Invalid", "invalid.graphql", true},
+		{"Breaking", "breaking.graphql", true},
+		{"Compatible", "compatible.graphql", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &Config{
+				Schema:            []string{filepath.Join(dir, "schema.graphql")},
+				Operations:        []string{filepath.Join(dir, "operations.graphql")},
+				Package:           "test",
+				Generated:         "generated.go",
+				ContextType:       "-",
+				Optional:          "pointer",
+				UnknownEnumValues: "reject",
+			}
+			err := checkSchema(config, []string{filepath.Join(dir, test.newSchema)})
+			if !test.wantError {
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			testutil.Cupaloy.SnapshotT(t, err.Error())
+		})
+	}
+}
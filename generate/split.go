@@ -0,0 +1,235 @@
+package generate
+
+// This file decides which of the generated types and operations go in which
+// of the generated files, per the split_output option.
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// generatedHeader is the first line of each Go file genqlient generates (see
+// header.go.tmpl).
+const generatedHeader = "// Code generated by github.com/Khan/genqlient, DO NOT EDIT.\n"
+
+// outputFile is a Go file we will generate, along with the types (by name,
+// from g.typeMap) and operations it will contain.
+type outputFile struct {
+	filename   string
+	typeNames  []string
+	operations []*operation
+}
+
+// splitOutput decides which types and operations go in which files,
+// returning the files sorted by filename.
+//
+// By default, that's all of them in config.Generated.  With split_output,
+// each operation goes in a file named for its source file or for the
+// operation itself, along with any types only it (or only the other
+// operations in that file) use.  Everything else -- enums, input types,
+// fragments, and any types needed by several files -- goes in
+// config.Generated, which we call the shared file.
+func (g *generator) splitOutput() ([]*outputFile, error) {
+	names := make([]string, 0, len(g.typeMap))
+	for name := range g.typeMap {
+		names = append(names, name)
+	}
+	// Sort alphabetically by type-name.  Sorting somehow deterministically is
+	// important to ensure generated code is deterministic.  Alphabetical is
+	// nice because it's easy, and in the current naming scheme, it's even
+	// vaguely aligned to the structure of the queries.
+	sort.Strings(names)
+
+	shared := &outputFile{filename: g.Config.Generated}
+	if g.Config.SplitOutput == "" || g.Config.SplitOutput == "none" {
+		shared.typeNames = names
+		shared.operations = g.Operations
+		return []*outputFile{shared}, nil
+	}
+
+	dir := filepath.Dir(g.Config.Generated)
+	suffix := "_" + strings.TrimSuffix(filepath.Base(g.Config.Generated), ".go") + ".go"
+
+	// First, figure out which file each operation goes in.
+	files := map[string]*outputFile{}
+	fileSources := map[string]string{} // filename -> what it's named for
+	opFiles := make(map[*operation]*outputFile, len(g.Operations))
+	for _, op := range g.Operations {
+		var source, filename string
+		switch g.Config.SplitOutput {
+		case "file":
+			source = op.SourceFilename
+			base := filepath.Base(source)
+			filename = strings.TrimSuffix(base, filepath.Ext(base))
+		case "operation":
+			source = "operation " + op.Name
+			filename = camelToSnake(op.Name)
+		}
+		filename = filepath.Join(dir, filename+suffix)
+
+		if other, ok := fileSources[filename]; ok && other != source {
+			return nil, errorf(nil,
+				"split_output: code for %v and %v would both be written to %v; "+
+					"rename one of them", other, source, filename)
+		}
+		fileSources[filename] = source
+
+		file := files[filename]
+		if file == nil {
+			file = &outputFile{filename: filename}
+			files[filename] = file
+		}
+		file.operations = append(file.operations, op)
+		opFiles[op] = file
+	}
+
+	// Then, figure out which file each type goes in: the file of the
+	// operation(s) that use it, if that's just one, else the shared file.
+	typeNames := make(map[goType]string, len(g.typeMap))
+	for name, typ := range g.typeMap {
+		typeNames[typ] = name
+	}
+	typeFiles := map[string]*outputFile{}
+	claim := func(root goType, file *outputFile) {
+		for name := range reachableTypes(root, typeNames) {
+			if other, ok := typeFiles[name]; ok && other != file {
+				typeFiles[name] = shared
+			} else {
+				typeFiles[name] = file
+			}
+		}
+	}
+
+	// Fragments are shared, as is anything they use, even if only one
+	// operation spreads them.
+	for name := range g.fragments {
+		if typ, ok := g.typeMap[name]; ok {
+			claim(typ, shared)
+		}
+	}
+	opInputs := map[string]bool{}
+	for _, op := range g.Operations {
+		claim(g.typeMap[op.ResponseName], opFiles[op])
+		if op.Input != nil {
+			claim(op.Input, opFiles[op])
+			opInputs[op.Input.GoName] = true
+		}
+	}
+
+	for _, name := range names {
+		file := typeFiles[name]
+		switch typ := g.typeMap[name].(type) {
+		case *goEnumType:
+			// Enums and input types correspond exactly to types in the
+			// schema, so they belong in the shared file even if they're only
+			// used in one place for now.  (The operation's own input type is
+			// the exception.)
+			file = shared
+		case *goStructType:
+			if typ.IsInput && !opInputs[name] {
+				file = shared
+			}
+		}
+		if file == nil {
+			file = shared // should be unreachable, but play it safe
+		}
+		file.typeNames = append(file.typeNames, name)
+	}
+
+	retval := make([]*outputFile, 0, len(files)+1)
+	retval = append(retval, shared)
+	for _, file := range files {
+		retval = append(retval, file)
+	}
+	sort.Slice(retval, func(i, j int) bool {
+		return retval[i].filename < retval[j].filename
+	})
+	return retval, nil
+}
+
+// staleGeneratedFiles returns the Go files, in the directories of config's
+// split generated code, which genqlient generated but which aren't among the
+// given generated files, sorted by name.  These are most likely left over
+// from operations files (or, with split_output: operation, operations) which
+// have since been renamed or removed, and would otherwise still be compiled
+// into the package.  (Without split_output, the generated files are always
+// the same, so we leave the directory alone.)
+func staleGeneratedFiles(config *Config, generated map[string][]byte) ([]string, error) {
+	dirs := map[string]bool{}
+	var stale []string
+	for _, project := range config.allProjects() {
+		dir := filepath.Dir(project.Generated)
+		if project.SplitOutput == "" || project.SplitOutput == "none" || dirs[dir] {
+			continue
+		}
+		dirs[dir] = true
+
+		filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, errorf(nil, "could not list generated files in %v: %v", dir, err)
+		}
+		for _, filename := range filenames {
+			if _, ok := generated[filename]; ok {
+				continue
+			}
+			content, err := os.ReadFile(filename)
+			if err != nil {
+				return nil, errorf(nil, "could not read %v: %v", filename, err)
+			}
+			if bytes.HasPrefix(content, []byte(generatedHeader)) {
+				stale = append(stale, filename)
+			}
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// reachableTypes returns the names of the types in typeNames (a reverse
+// g.typeMap) reachable from the given type, including itself.
+func reachableTypes(root goType, typeNames map[goType]string) map[string]bool {
+	seen := map[string]bool{}
+	var visit func(typ goType)
+	visit = func(typ goType) {
+		if typ == nil {
+			return
+		}
+		if name, ok := typeNames[typ]; ok {
+			if seen[name] {
+				return
+			}
+			seen[name] = true
+		}
+
+		switch typ := typ.(type) {
+		case *goSliceType:
+			visit(typ.Elem)
+		case *goPointerType:
+			visit(typ.Elem)
+		case *goGenericType:
+			visit(typ.Elem)
+		case *goFlattenedType:
+			visit(typ.Wrapper)
+			visit(typ.Elem)
+		case *goStructType:
+			for _, field := range typ.Fields {
+				visit(field.GoType)
+			}
+		case *goInterfaceType:
+			for _, field := range typ.SharedFields {
+				visit(field.GoType)
+			}
+			for _, impl := range typ.Implementations {
+				visit(impl)
+			}
+			if typ.Unknown != nil {
+				visit(typ.Unknown)
+			}
+		}
+	}
+	visit(root)
+	return seen
+}
@@ -3,8 +3,11 @@ package generate
 import (
 	"embed"
 	"io"
+	"os"
+	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
 )
 
 //go:embed *.tmpl
@@ -28,24 +31,123 @@ func intRange(n int) []int {
 
 func sub(x, y int) int { return x - y }
 
-// render executes the given template with the funcs from this generator.
-func (g *generator) render(tmplRelFilename string, w io.Writer, data interface{}) error {
-	tmpl := g.templateCache[tmplRelFilename]
-	if tmpl == nil {
-		funcMap := template.FuncMap{
-			"ref":      g.ref,
-			"repeat":   repeat,
-			"intRange": intRange,
-			"sub":      sub,
+// comment formats the given text as a Go comment (one // line per line of
+// text), or returns "" if it's empty.
+func comment(text string) string {
+	var builder strings.Builder
+	writeDescription(&builder, text)
+	return builder.String()
+}
+
+// loadTemplates parses genqlient's templates, along with any overrides from
+// the templates option in genqlient.yaml, into g.templates.
+//
+// All the templates are parsed into a single set, such that each file, and
+// each {{define}} within it, is a named template which may be overridden.
+// See docs/templates.md for the names, and the data passed to each.
+func (g *generator) loadTemplates() error {
+	if g.templates != nil {
+		return nil
+	}
+
+	funcMap := template.FuncMap{
+		"ref":      g.ref,
+		"repeat":   repeat,
+		"intRange": intRange,
+		"sub":      sub,
+		"comment":  comment,
+	}
+	tmpl, err := template.New("").Funcs(funcMap).ParseFS(templates, "*.tmpl")
+	if err != nil {
+		return errorf(nil, "could not load templates: %v", err)
+	}
+	builtinNames := map[string]bool{}
+	for _, t := range tmpl.Templates() {
+		builtinNames[t.Name()] = true
+	}
+
+	// Each override file may only define templates, which must either
+	// replace one of ours, or be called from another override (presumably
+	// as a helper).  Otherwise it's probably a typo, which would silently do
+	// nothing.
+	var defined []string
+	called := map[string]bool{}
+	for _, filename := range g.Config.Templates {
+		text, err := os.ReadFile(filename)
+		if err != nil {
+			return errorf(nil, "could not read template overrides: %v", err)
 		}
-		var err error
-		tmpl, err = template.New(tmplRelFilename).Funcs(funcMap).ParseFS(templates, tmplRelFilename)
+
+		// Parse the file on its own first, so we know what it defines.
+		fileTmpl, err := template.New(filename).Funcs(funcMap).Parse(string(text))
 		if err != nil {
-			return errorf(nil, "could not load template %v: %v", tmplRelFilename, err)
+			return errorf(nil, "invalid template overrides: %v", err)
+		}
+		for _, t := range fileTmpl.Templates() {
+			if t.Name() == filename {
+				if t.Tree != nil && strings.TrimSpace(t.Tree.Root.String()) != "" {
+					return errorf(nil, "invalid template overrides in %v: "+
+						"all text must be within {{define}} blocks", filename)
+				}
+				continue
+			}
+			defined = append(defined, t.Name())
+			if t.Tree != nil {
+				findTemplateCalls(t.Tree.Root, called)
+			}
 		}
-		g.templateCache[tmplRelFilename] = tmpl
+
+		_, err = tmpl.New(filename).Parse(string(text))
+		if err != nil {
+			return errorf(nil, "invalid template overrides: %v", err)
+		}
+	}
+
+	sort.Strings(defined)
+	for _, name := range defined {
+		if !builtinNames[name] && !called[name] {
+			return errorf(nil, "template overrides define %q, which is "+
+				"neither a genqlient template nor used by another override; "+
+				"see docs/templates.md for the available templates", name)
+		}
+	}
+
+	g.templates = tmpl
+	return nil
+}
+
+// findTemplateCalls adds to called the names of all the templates called
+// (via {{template "name"}}) within the given template node.
+func findTemplateCalls(node parse.Node, called map[string]bool) {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+		for _, child := range node.Nodes {
+			findTemplateCalls(child, called)
+		}
+	case *parse.TemplateNode:
+		called[node.Name] = true
+	case *parse.IfNode:
+		findTemplateCalls(node.List, called)
+		findTemplateCalls(node.ElseList, called)
+	case *parse.RangeNode:
+		findTemplateCalls(node.List, called)
+		findTemplateCalls(node.ElseList, called)
+	case *parse.WithNode:
+		findTemplateCalls(node.List, called)
+		findTemplateCalls(node.ElseList, called)
+	}
+}
+
+// render executes the given template with the funcs from this generator.
+func (g *generator) render(tmplName string, w io.Writer, data interface{}) error {
+	err := g.loadTemplates()
+	if err != nil {
+		return err
 	}
-	err := tmpl.Execute(w, data)
+	err = g.templates.ExecuteTemplate(w, tmplName, data)
 	if err != nil {
 		return errorf(nil, "could not render template: %v", err)
 	}
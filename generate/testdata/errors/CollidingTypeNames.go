@@ -0,0 +1,8 @@
+package errors
+
+const _ = `# @genqlient
+query CollidingTypeNames {
+  aB { x }
+  a { x }
+}
+`
@@ -0,0 +1,9 @@
+package errors
+
+const _ = `# @genqlient
+	query GetUser { user { id email } }
+`
+
+const _ = `# @genqlient
+	query GetF { g }
+`
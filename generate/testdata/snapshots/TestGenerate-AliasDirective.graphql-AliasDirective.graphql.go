@@ -47,12 +47,17 @@ query AliasDirective {
 }
 `
 
+// The SHA-256 hash of AliasDirective_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const AliasDirective_OperationHash = "38c54573ca5732f504a25d0409e26af2b1c0f66cae7d4c8f46ec91d2535febbd"
+
 func AliasDirective(
 	client_ graphql.Client,
 ) (data_ *AliasDirectiveResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "AliasDirective",
 		Query:  AliasDirective_Operation,
+		Hash:   AliasDirective_OperationHash,
 	}
 
 	data_ = &AliasDirectiveResponse{}
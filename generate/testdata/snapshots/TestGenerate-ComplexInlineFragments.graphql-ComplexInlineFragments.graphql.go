@@ -1429,6 +1429,10 @@ query ComplexInlineFragments {
 }
 `
 
+// The SHA-256 hash of ComplexInlineFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexInlineFragments_OperationHash = "f09843b664f7b57ac9038c111235c36eed3d41ac6bce61f915d5e2999389cc38"
+
 // We test all the spread cases from docs/design.md, see there for more context
 // on each, as well as various other nonsense.  But for abstract-in-abstract
 // spreads, we can't test cases (4b) and (4c), where I implements J or vice
@@ -1440,6 +1444,7 @@ func ComplexInlineFragments(
 	req_ := &graphql.Request{
 		OpName: "ComplexInlineFragments",
 		Query:  ComplexInlineFragments_Operation,
+		Hash:   ComplexInlineFragments_OperationHash,
 	}
 
 	data_ = &ComplexInlineFragmentsResponse{}
@@ -2599,12 +2599,17 @@ fragment MoreVideoFields on Video {
 }
 `
 
+// The SHA-256 hash of ComplexNamedFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragments_OperationHash = "958c554723f092e64f904fb70bc393c2d71aa9e895726b03efcf921b4ecdfbe2"
+
 func ComplexNamedFragments(
 	client_ graphql.Client,
 ) (data_ *ComplexNamedFragmentsResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "ComplexNamedFragments",
 		Query:  ComplexNamedFragments_Operation,
+		Hash:   ComplexNamedFragments_OperationHash,
 	}
 
 	data_ = &ComplexNamedFragmentsResponse{}
@@ -2651,12 +2656,17 @@ fragment SimpleLeafContent on LeafContent {
 }
 `
 
+// The SHA-256 hash of ComplexNamedFragmentsWithInlineUnion_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragmentsWithInlineUnion_OperationHash = "97778b892eb352d0e3f48b1248fb7e5bebaaec3ddd2e3de3fd12d7d910269464"
+
 func ComplexNamedFragmentsWithInlineUnion(
 	client_ graphql.Client,
 ) (data_ *ComplexNamedFragmentsWithInlineUnionResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "ComplexNamedFragmentsWithInlineUnion",
 		Query:  ComplexNamedFragmentsWithInlineUnion_Operation,
+		Hash:   ComplexNamedFragmentsWithInlineUnion_OperationHash,
 	}
 
 	data_ = &ComplexNamedFragmentsWithInlineUnionResponse{}
@@ -2392,12 +2392,17 @@ fragment TopicFields on Topic {
 }
 `
 
+// The SHA-256 hash of CovariantInterfaceImplementation_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const CovariantInterfaceImplementation_OperationHash = "4de880441757b4ce4ac6a4be3dac9f140d47be41c65140028be2ebbe7c5016b0"
+
 func CovariantInterfaceImplementation(
 	client_ graphql.Client,
 ) (data_ *CovariantInterfaceImplementationResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "CovariantInterfaceImplementation",
 		Query:  CovariantInterfaceImplementation_Operation,
+		Hash:   CovariantInterfaceImplementation_OperationHash,
 	}
 
 	data_ = &CovariantInterfaceImplementationResponse{}
@@ -184,6 +184,10 @@ query CustomMarshal ($date: Date!) {
 }
 `
 
+// The SHA-256 hash of CustomMarshal_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const CustomMarshal_OperationHash = "1f3275dbf79603129b29d2f3f64d3c68bef1826ae5bd30fac8a002bddd8a70d3"
+
 func CustomMarshal(
 	client_ graphql.Client,
 	date time.Time,
@@ -191,6 +195,7 @@ func CustomMarshal(
 	req_ := &graphql.Request{
 		OpName: "CustomMarshal",
 		Query:  CustomMarshal_Operation,
+		Hash:   CustomMarshal_OperationHash,
 		Variables: &__CustomMarshalInput{
 			Date: date,
 		},
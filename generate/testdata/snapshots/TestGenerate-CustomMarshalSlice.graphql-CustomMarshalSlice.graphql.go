@@ -211,6 +211,10 @@ query CustomMarshalSlice ($datesss: [[[Date!]!]!]!, $datesssp: [[[Date!]!]!]!) {
 }
 `
 
+// The SHA-256 hash of CustomMarshalSlice_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const CustomMarshalSlice_OperationHash = "d7203769079f341ac6cfc2984d993ef54bd781831bc86037fef55ccf4e216bc1"
+
 func CustomMarshalSlice(
 	client_ graphql.Client,
 	datesss [][][]time.Time,
@@ -219,6 +223,7 @@ func CustomMarshalSlice(
 	req_ := &graphql.Request{
 		OpName: "CustomMarshalSlice",
 		Query:  CustomMarshalSlice_Operation,
+		Hash:   CustomMarshalSlice_OperationHash,
 		Variables: &__CustomMarshalSliceInput{
 			Datesss:  datesss,
 			Datesssp: datesssp,
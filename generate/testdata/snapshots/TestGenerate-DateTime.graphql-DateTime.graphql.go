@@ -35,6 +35,10 @@ query convertTimezone ($dt: DateTime!, $tz: String) {
 }
 `
 
+// The SHA-256 hash of convertTimezone_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const convertTimezone_OperationHash = "a1245b310182fd1d1525790d7341925f9934ade569d30ebf2630d1445ad8caa9"
+
 func convertTimezone(
 	client_ graphql.Client,
 	dt time.Time,
@@ -43,6 +47,7 @@ func convertTimezone(
 	req_ := &graphql.Request{
 		OpName: "convertTimezone",
 		Query:  convertTimezone_Operation,
+		Hash:   convertTimezone_OperationHash,
 		Variables: &__convertTimezoneInput{
 			Dt: dt,
 			Tz: tz,
@@ -0,0 +1,447 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package test
+
+import (
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// BasicUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type BasicUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id          testutil.ID                      `json:"id"`
+	Name        string                           `json:"name"`
+	AuthMethods []BasicUserAuthMethodsAuthMethod `json:"authMethods"`
+}
+
+// GetId returns BasicUser.Id, and is useful for accessing the field via an interface.
+func (v *BasicUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns BasicUser.Name, and is useful for accessing the field via an interface.
+func (v *BasicUser) GetName() string { return v.Name }
+
+// GetAuthMethods returns BasicUser.AuthMethods, and is useful for accessing the field via an interface.
+func (v *BasicUser) GetAuthMethods() []BasicUserAuthMethodsAuthMethod { return v.AuthMethods }
+
+// BasicUserAuthMethodsAuthMethod includes the requested fields of the GraphQL type AuthMethod.
+type BasicUserAuthMethodsAuthMethod struct {
+	Provider string `json:"provider"`
+	Email    string `json:"email"`
+}
+
+// GetProvider returns BasicUserAuthMethodsAuthMethod.Provider, and is useful for accessing the field via an interface.
+func (v *BasicUserAuthMethodsAuthMethod) GetProvider() string { return v.Provider }
+
+// GetEmail returns BasicUserAuthMethodsAuthMethod.Email, and is useful for accessing the field via an interface.
+func (v *BasicUserAuthMethodsAuthMethod) GetEmail() string { return v.Email }
+
+// GetNamedUserResponse is returned by GetNamedUser on success.
+type GetNamedUserResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User BasicUser `json:"user"`
+}
+
+// GetUser returns GetNamedUserResponse.User, and is useful for accessing the field via an interface.
+func (v *GetNamedUserResponse) GetUser() BasicUser { return v.User }
+
+// GetUserPointerResponse is returned by GetUserPointer on success.
+type GetUserPointerResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User GetUserPointerUser `json:"user"`
+}
+
+// GetUser returns GetUserPointerResponse.User, and is useful for accessing the field via an interface.
+func (v *GetUserPointerResponse) GetUser() GetUserPointerUser { return v.User }
+
+// GetUserPointerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type GetUserPointerUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id   testutil.ID `json:"id"`
+	Name *string     `json:"name"`
+}
+
+// GetId returns GetUserPointerUser.Id, and is useful for accessing the field via an interface.
+func (v *GetUserPointerUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns GetUserPointerUser.Name, and is useful for accessing the field via an interface.
+func (v *GetUserPointerUser) GetName() *string { return v.Name }
+
+// GetUserResponse is returned by GetUser on success.
+type GetUserResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User GetUserUser `json:"user"`
+}
+
+// GetUser returns GetUserResponse.User, and is useful for accessing the field via an interface.
+func (v *GetUserResponse) GetUser() GetUserUser { return v.User }
+
+// GetUserUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type GetUserUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id          testutil.ID                        `json:"id"`
+	Name        string                             `json:"name"`
+	AuthMethods []GetUserUserAuthMethodsAuthMethod `json:"authMethods"`
+}
+
+// GetId returns GetUserUser.Id, and is useful for accessing the field via an interface.
+func (v *GetUserUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns GetUserUser.Name, and is useful for accessing the field via an interface.
+func (v *GetUserUser) GetName() string { return v.Name }
+
+// GetAuthMethods returns GetUserUser.AuthMethods, and is useful for accessing the field via an interface.
+func (v *GetUserUser) GetAuthMethods() []GetUserUserAuthMethodsAuthMethod { return v.AuthMethods }
+
+// GetUserUserAuthMethodsAuthMethod includes the requested fields of the GraphQL type AuthMethod.
+type GetUserUserAuthMethodsAuthMethod struct {
+	Provider string `json:"provider"`
+	Email    string `json:"email"`
+}
+
+// GetProvider returns GetUserUserAuthMethodsAuthMethod.Provider, and is useful for accessing the field via an interface.
+func (v *GetUserUserAuthMethodsAuthMethod) GetProvider() string { return v.Provider }
+
+// GetEmail returns GetUserUserAuthMethodsAuthMethod.Email, and is useful for accessing the field via an interface.
+func (v *GetUserUserAuthMethodsAuthMethod) GetEmail() string { return v.Email }
+
+// ListUsersResponse is returned by ListUsers on success.
+type ListUsersResponse struct {
+	Users []ListUsersUsersUser `json:"users"`
+}
+
+// GetUsers returns ListUsersResponse.Users, and is useful for accessing the field via an interface.
+func (v *ListUsersResponse) GetUsers() []ListUsersUsersUser { return v.Users }
+
+// ListUsersUsersUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type ListUsersUsersUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id          testutil.ID                               `json:"id"`
+	Name        string                                    `json:"name"`
+	AuthMethods []ListUsersUsersUserAuthMethodsAuthMethod `json:"authMethods"`
+}
+
+// GetId returns ListUsersUsersUser.Id, and is useful for accessing the field via an interface.
+func (v *ListUsersUsersUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns ListUsersUsersUser.Name, and is useful for accessing the field via an interface.
+func (v *ListUsersUsersUser) GetName() string { return v.Name }
+
+// GetAuthMethods returns ListUsersUsersUser.AuthMethods, and is useful for accessing the field via an interface.
+func (v *ListUsersUsersUser) GetAuthMethods() []ListUsersUsersUserAuthMethodsAuthMethod {
+	return v.AuthMethods
+}
+
+// ListUsersUsersUserAuthMethodsAuthMethod includes the requested fields of the GraphQL type AuthMethod.
+type ListUsersUsersUserAuthMethodsAuthMethod struct {
+	Provider string `json:"provider"`
+	Email    string `json:"email"`
+}
+
+// GetProvider returns ListUsersUsersUserAuthMethodsAuthMethod.Provider, and is useful for accessing the field via an interface.
+func (v *ListUsersUsersUserAuthMethodsAuthMethod) GetProvider() string { return v.Provider }
+
+// GetEmail returns ListUsersUsersUserAuthMethodsAuthMethod.Email, and is useful for accessing the field via an interface.
+func (v *ListUsersUsersUserAuthMethodsAuthMethod) GetEmail() string { return v.Email }
+
+// Role is a type a user may have.
+type Role string
+
+const (
+	// What is a student?
+	//
+	// A student is primarily a person enrolled in a school or other educational institution and who is under learning with goals of acquiring knowledge, developing professions and achieving employment at desired field. In the broader sense, a student is anyone who applies themselves to the intensive intellectual engagement with some matter necessary to master it as part of some practical affair in which such mastery is basic or decisive.
+	//
+	// (from [Wikipedia](https://en.wikipedia.org/wiki/Student))
+	RoleStudent Role = "STUDENT"
+	// Teacher is a teacher, who teaches the students.
+	RoleTeacher Role = "TEACHER"
+)
+
+var AllRole = []Role{
+	RoleStudent,
+	RoleTeacher,
+}
+
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
+// UsersWithRoleResponse is returned by UsersWithRole on success.
+type UsersWithRoleResponse struct {
+	// usersWithRole looks a user up by role.
+	UsersWithRole []UsersWithRoleUsersWithRoleUser `json:"usersWithRole"`
+}
+
+// GetUsersWithRole returns UsersWithRoleResponse.UsersWithRole, and is useful for accessing the field via an interface.
+func (v *UsersWithRoleResponse) GetUsersWithRole() []UsersWithRoleUsersWithRoleUser {
+	return v.UsersWithRole
+}
+
+// UsersWithRoleUsersWithRoleUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type UsersWithRoleUsersWithRoleUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+}
+
+// GetId returns UsersWithRoleUsersWithRoleUser.Id, and is useful for accessing the field via an interface.
+func (v *UsersWithRoleUsersWithRoleUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns UsersWithRoleUsersWithRoleUser.Name, and is useful for accessing the field via an interface.
+func (v *UsersWithRoleUsersWithRoleUser) GetName() string { return v.Name }
+
+// __UsersWithRoleInput is used internally by genqlient
+type __UsersWithRoleInput struct {
+	Role Role `json:"role"`
+}
+
+// GetRole returns __UsersWithRoleInput.Role, and is useful for accessing the field via an interface.
+func (v *__UsersWithRoleInput) GetRole() Role { return v.Role }
+
+// The query executed by GetNamedUser.
+const GetNamedUser_Operation = `
+query GetNamedUser {
+	user {
+		id
+		name
+		authMethods {
+			provider
+			email
+		}
+	}
+}
+`
+
+// The SHA-256 hash of GetNamedUser_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const GetNamedUser_OperationHash = "19c6f540407766c7398fd4b5590b05d5514929e40b9c1c4e903326b22abe64cb"
+
+func GetNamedUser(
+	client_ graphql.Client,
+) (data_ *GetNamedUserResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetNamedUser",
+		Query:  GetNamedUser_Operation,
+		Hash:   GetNamedUser_OperationHash,
+	}
+
+	data_ = &GetNamedUserResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetUser.
+const GetUser_Operation = `
+query GetUser {
+	user {
+		id
+		name
+		authMethods {
+			provider
+			email
+		}
+	}
+}
+`
+
+// The SHA-256 hash of GetUser_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const GetUser_OperationHash = "84af1abbde960d3f07ef0ceaefad3cf998b64b7d62dae0937f24fb64f4f9fc81"
+
+// With dedupe_types, the user types (and their authMethods types) are shared,
+// except where the selections or directives differ.
+func GetUser(
+	client_ graphql.Client,
+) (data_ *GetUserResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetUser",
+		Query:  GetUser_Operation,
+		Hash:   GetUser_OperationHash,
+	}
+
+	data_ = &GetUserResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetUserPointer.
+const GetUserPointer_Operation = `
+query GetUserPointer {
+	user {
+		id
+		name
+	}
+}
+`
+
+// The SHA-256 hash of GetUserPointer_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const GetUserPointer_OperationHash = "00da1f835c2bf780897a05f0bd5d83b78c7097763eedbad95ed328b73a1deee5"
+
+func GetUserPointer(
+	client_ graphql.Client,
+) (data_ *GetUserPointerResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetUserPointer",
+		Query:  GetUserPointer_Operation,
+		Hash:   GetUserPointer_OperationHash,
+	}
+
+	data_ = &GetUserPointerResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListUsers.
+const ListUsers_Operation = `
+query ListUsers {
+	users {
+		id
+		name
+		authMethods {
+			provider
+			email
+		}
+	}
+}
+`
+
+// The SHA-256 hash of ListUsers_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ListUsers_OperationHash = "2458c27dcbfe6f24421f8e12a230b6937973c89996fa68944234e8fd3aaa060b"
+
+func ListUsers(
+	client_ graphql.Client,
+) (data_ *ListUsersResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListUsers",
+		Query:  ListUsers_Operation,
+		Hash:   ListUsers_OperationHash,
+	}
+
+	data_ = &ListUsersResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by UsersWithRole.
+const UsersWithRole_Operation = `
+query UsersWithRole ($role: Role!) {
+	usersWithRole(role: $role) {
+		id
+		name
+	}
+}
+`
+
+// The SHA-256 hash of UsersWithRole_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const UsersWithRole_OperationHash = "4c739028a4bc191e7552a8c021963f08dfc969565b5eeb590131659287c3f5ba"
+
+func UsersWithRole(
+	client_ graphql.Client,
+	role Role,
+) (data_ *UsersWithRoleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UsersWithRole",
+		Query:  UsersWithRole_Operation,
+		Hash:   UsersWithRole_OperationHash,
+		Variables: &__UsersWithRoleInput{
+			Role: role,
+		},
+	}
+
+	data_ = &UsersWithRoleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
@@ -40,6 +40,10 @@ query DefaultInputs ($input: InputWithDefaults!) {
 }
 `
 
+// The SHA-256 hash of DefaultInputs_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const DefaultInputs_OperationHash = "b1a8b9014a9b40ceec3938b0f2e515bba7da1165eb9b35e48553651c19bd3f31"
+
 func DefaultInputs(
 	client_ graphql.Client,
 	input InputWithDefaults,
@@ -47,6 +51,7 @@ func DefaultInputs(
 	req_ := &graphql.Request{
 		OpName: "DefaultInputs",
 		Query:  DefaultInputs_Operation,
+		Hash:   DefaultInputs_OperationHash,
 		Variables: &__DefaultInputsInput{
 			Input: input,
 		},
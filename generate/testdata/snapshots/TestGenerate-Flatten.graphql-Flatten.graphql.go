@@ -304,12 +304,17 @@ fragment ChildVideoFields on Video {
 }
 `
 
+// The SHA-256 hash of ComplexNamedFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragments_OperationHash = "cb1f1eadfc828200f17fb9d21d4f98d5d2cc1f5873d31abfeb55cdad7ebb5327"
+
 func ComplexNamedFragments(
 	client_ graphql.Client,
 ) (data_ *InnerQueryFragment, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "ComplexNamedFragments",
 		Query:  ComplexNamedFragments_Operation,
+		Hash:   ComplexNamedFragments_OperationHash,
 	}
 
 	data_ = &InnerQueryFragment{}
@@ -0,0 +1,723 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// ContentFields includes the GraphQL fields of Content requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+//
+// ContentFields is implemented by the following types:
+// ContentFieldsArticle
+// ContentFieldsTopic
+// ContentFieldsVideo
+type ContentFields interface {
+	implementsGraphQLInterfaceContentFields()
+	// GetName returns the interface-field "name" from its implementation.
+	GetName() string
+}
+
+func (v *ContentFieldsArticle) implementsGraphQLInterfaceContentFields() {}
+func (v *ContentFieldsTopic) implementsGraphQLInterfaceContentFields()   {}
+func (v *ContentFieldsVideo) implementsGraphQLInterfaceContentFields()   {}
+
+func __unmarshalContentFields(b []byte, v *ContentFields) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(ContentFieldsArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(ContentFieldsTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(ContentFieldsVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ContentFields: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalContentFields(v *ContentFields) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ContentFieldsArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContentFieldsTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContentFieldsVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ContentFields: "%T"`, v)
+	}
+}
+
+// ContentFields includes the GraphQL fields of Article requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsArticle struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ContentFieldsArticle.Name, and is useful for accessing the field via an interface.
+func (v *ContentFieldsArticle) GetName() string { return v.Name }
+
+// ContentFields includes the GraphQL fields of Topic requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsTopic struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ContentFieldsTopic.Name, and is useful for accessing the field via an interface.
+func (v *ContentFieldsTopic) GetName() string { return v.Name }
+
+// ContentFields includes the GraphQL fields of Video requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsVideo struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ContentFieldsVideo.Name, and is useful for accessing the field via an interface.
+func (v *ContentFieldsVideo) GetName() string { return v.Name }
+
+// FlattenFieldRandomVideoParentTopic includes the requested fields of the GraphQL type Topic.
+type FlattenFieldRandomVideoParentTopic struct {
+	ContentFieldsTopic `json:"-"`
+}
+
+// GetName returns FlattenFieldRandomVideoParentTopic.Name, and is useful for accessing the field via an interface.
+func (v *FlattenFieldRandomVideoParentTopic) GetName() string { return v.ContentFieldsTopic.Name }
+
+func (v *FlattenFieldRandomVideoParentTopic) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*FlattenFieldRandomVideoParentTopic
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.FlattenFieldRandomVideoParentTopic = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContentFieldsTopic)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalFlattenFieldRandomVideoParentTopic struct {
+	Name string `json:"name"`
+}
+
+func (v *FlattenFieldRandomVideoParentTopic) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *FlattenFieldRandomVideoParentTopic) __premarshalJSON() (*__premarshalFlattenFieldRandomVideoParentTopic, error) {
+	var retval __premarshalFlattenFieldRandomVideoParentTopic
+
+	retval.Name = v.ContentFieldsTopic.Name
+	return &retval, nil
+}
+
+// FlattenFieldResponse is returned by FlattenField on success.
+type FlattenFieldResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User        string                                        `json:"-"`
+	Users       []FlattenFieldUsersUserLastContentLeafContent `json:"-"`
+	RandomItem  testutil.ID                                   `json:"-"`
+	RandomVideo FlattenFieldRandomVideoParentTopic            `json:"-"`
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	OtherUser []string `json:"-"`
+}
+
+// GetUser returns FlattenFieldResponse.User, and is useful for accessing the field via an interface.
+func (v *FlattenFieldResponse) GetUser() string { return v.User }
+
+// GetUsers returns FlattenFieldResponse.Users, and is useful for accessing the field via an interface.
+func (v *FlattenFieldResponse) GetUsers() []FlattenFieldUsersUserLastContentLeafContent {
+	return v.Users
+}
+
+// GetRandomItem returns FlattenFieldResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *FlattenFieldResponse) GetRandomItem() testutil.ID { return v.RandomItem }
+
+// GetRandomVideo returns FlattenFieldResponse.RandomVideo, and is useful for accessing the field via an interface.
+func (v *FlattenFieldResponse) GetRandomVideo() FlattenFieldRandomVideoParentTopic {
+	return v.RandomVideo
+}
+
+// GetOtherUser returns FlattenFieldResponse.OtherUser, and is useful for accessing the field via an interface.
+func (v *FlattenFieldResponse) GetOtherUser() []string { return v.OtherUser }
+
+func (v *FlattenFieldResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*FlattenFieldResponse
+		User        json.RawMessage   `json:"user"`
+		Users       []json.RawMessage `json:"users"`
+		RandomItem  json.RawMessage   `json:"randomItem"`
+		RandomVideo json.RawMessage   `json:"randomVideo"`
+		OtherUser   json.RawMessage   `json:"otherUser"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.FlattenFieldResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.User
+		src := firstPass.User
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedFlattenFieldUser(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal FlattenFieldResponse.User: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Users
+		src := firstPass.Users
+		*dst = make(
+			[]FlattenFieldUsersUserLastContentLeafContent,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalFlattenedFlattenFieldUsersUser(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal FlattenFieldResponse.Users: %w", err)
+				}
+			}
+		}
+	}
+
+	{
+		dst := &v.RandomItem
+		src := firstPass.RandomItem
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedFlattenFieldRandomItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal FlattenFieldResponse.RandomItem: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.RandomVideo
+		src := firstPass.RandomVideo
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedFlattenFieldRandomVideo(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal FlattenFieldResponse.RandomVideo: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.OtherUser
+		src := firstPass.OtherUser
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedFlattenFieldOtherUser(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal FlattenFieldResponse.OtherUser: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalFlattenFieldResponse struct {
+	User json.RawMessage `json:"user"`
+
+	Users []json.RawMessage `json:"users"`
+
+	RandomItem json.RawMessage `json:"randomItem"`
+
+	RandomVideo json.RawMessage `json:"randomVideo"`
+
+	OtherUser json.RawMessage `json:"otherUser"`
+}
+
+func (v *FlattenFieldResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *FlattenFieldResponse) __premarshalJSON() (*__premarshalFlattenFieldResponse, error) {
+	var retval __premarshalFlattenFieldResponse
+
+	{
+
+		dst := &retval.User
+		src := v.User
+		var err error
+		*dst, err = __marshalFlattenedFlattenFieldUser(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal FlattenFieldResponse.User: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Users
+		src := v.Users
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalFlattenedFlattenFieldUsersUser(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal FlattenFieldResponse.Users: %w", err)
+			}
+		}
+	}
+	{
+
+		dst := &retval.RandomItem
+		src := v.RandomItem
+		var err error
+		*dst, err = __marshalFlattenedFlattenFieldRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal FlattenFieldResponse.RandomItem: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.RandomVideo
+		src := v.RandomVideo
+		var err error
+		*dst, err = __marshalFlattenedFlattenFieldRandomVideo(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal FlattenFieldResponse.RandomVideo: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.OtherUser
+		src := v.OtherUser
+		var err error
+		*dst, err = __marshalFlattenedFlattenFieldOtherUser(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal FlattenFieldResponse.OtherUser: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// FlattenFieldUsersUserLastContentArticle includes the requested fields of the GraphQL type Article.
+type FlattenFieldUsersUserLastContentArticle struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns FlattenFieldUsersUserLastContentArticle.Typename, and is useful for accessing the field via an interface.
+func (v *FlattenFieldUsersUserLastContentArticle) GetTypename() string { return v.Typename }
+
+// FlattenFieldUsersUserLastContentLeafContent includes the requested fields of the GraphQL interface LeafContent.
+//
+// FlattenFieldUsersUserLastContentLeafContent is implemented by the following types:
+// FlattenFieldUsersUserLastContentArticle
+// FlattenFieldUsersUserLastContentVideo
+// The GraphQL type's documentation follows.
+//
+// LeafContent represents content items that can't have child-nodes.
+type FlattenFieldUsersUserLastContentLeafContent interface {
+	implementsGraphQLInterfaceFlattenFieldUsersUserLastContentLeafContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *FlattenFieldUsersUserLastContentArticle) implementsGraphQLInterfaceFlattenFieldUsersUserLastContentLeafContent() {
+}
+func (v *FlattenFieldUsersUserLastContentVideo) implementsGraphQLInterfaceFlattenFieldUsersUserLastContentLeafContent() {
+}
+
+func __unmarshalFlattenFieldUsersUserLastContentLeafContent(b []byte, v *FlattenFieldUsersUserLastContentLeafContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(FlattenFieldUsersUserLastContentArticle)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(FlattenFieldUsersUserLastContentVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing LeafContent.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for FlattenFieldUsersUserLastContentLeafContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalFlattenFieldUsersUserLastContentLeafContent(v *FlattenFieldUsersUserLastContentLeafContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *FlattenFieldUsersUserLastContentArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*FlattenFieldUsersUserLastContentArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *FlattenFieldUsersUserLastContentVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*FlattenFieldUsersUserLastContentVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for FlattenFieldUsersUserLastContentLeafContent: "%T"`, v)
+	}
+}
+
+// FlattenFieldUsersUserLastContentVideo includes the requested fields of the GraphQL type Video.
+type FlattenFieldUsersUserLastContentVideo struct {
+	Typename string `json:"__typename"`
+	Duration int    `json:"duration"`
+}
+
+// GetTypename returns FlattenFieldUsersUserLastContentVideo.Typename, and is useful for accessing the field via an interface.
+func (v *FlattenFieldUsersUserLastContentVideo) GetTypename() string { return v.Typename }
+
+// GetDuration returns FlattenFieldUsersUserLastContentVideo.Duration, and is useful for accessing the field via an interface.
+func (v *FlattenFieldUsersUserLastContentVideo) GetDuration() int { return v.Duration }
+
+// __flattenedFlattenFieldOtherUser is used internally by genqlient to (un)marshal the flattened field emails.
+type __flattenedFlattenFieldOtherUser struct {
+	Emails []string `json:"emails"`
+}
+
+// GetEmails returns __flattenedFlattenFieldOtherUser.Emails, and is useful for accessing the field via an interface.
+func (v *__flattenedFlattenFieldOtherUser) GetEmails() []string { return v.Emails }
+
+func __unmarshalFlattenedFlattenFieldOtherUser(b []byte, v *[]string) error {
+	var wrapper __flattenedFlattenFieldOtherUser
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Emails
+	return err
+}
+
+func __marshalFlattenedFlattenFieldOtherUser(v *[]string) ([]byte, error) {
+	return json.Marshal(&__flattenedFlattenFieldOtherUser{Emails: *v})
+}
+
+// __flattenedFlattenFieldRandomItemContent is used internally by genqlient to (un)marshal the flattened field id.
+type __flattenedFlattenFieldRandomItemContent struct {
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns __flattenedFlattenFieldRandomItemContent.Id, and is useful for accessing the field via an interface.
+func (v *__flattenedFlattenFieldRandomItemContent) GetId() testutil.ID { return v.Id }
+
+func __unmarshalFlattenedFlattenFieldRandomItemContent(b []byte, v *testutil.ID) error {
+	var wrapper __flattenedFlattenFieldRandomItemContent
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Id
+	return err
+}
+
+func __marshalFlattenedFlattenFieldRandomItemContent(v *testutil.ID) ([]byte, error) {
+	return json.Marshal(&__flattenedFlattenFieldRandomItemContent{Id: *v})
+}
+
+// __flattenedFlattenFieldRandomVideo is used internally by genqlient to (un)marshal the flattened field parent.
+type __flattenedFlattenFieldRandomVideo struct {
+	Parent FlattenFieldRandomVideoParentTopic `json:"parent"`
+}
+
+// GetParent returns __flattenedFlattenFieldRandomVideo.Parent, and is useful for accessing the field via an interface.
+func (v *__flattenedFlattenFieldRandomVideo) GetParent() FlattenFieldRandomVideoParentTopic {
+	return v.Parent
+}
+
+func __unmarshalFlattenedFlattenFieldRandomVideo(b []byte, v *FlattenFieldRandomVideoParentTopic) error {
+	var wrapper __flattenedFlattenFieldRandomVideo
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Parent
+	return err
+}
+
+func __marshalFlattenedFlattenFieldRandomVideo(v *FlattenFieldRandomVideoParentTopic) ([]byte, error) {
+	return json.Marshal(&__flattenedFlattenFieldRandomVideo{Parent: *v})
+}
+
+// __flattenedFlattenFieldUser is used internally by genqlient to (un)marshal the flattened field name.
+type __flattenedFlattenFieldUser struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __flattenedFlattenFieldUser.Name, and is useful for accessing the field via an interface.
+func (v *__flattenedFlattenFieldUser) GetName() string { return v.Name }
+
+func __unmarshalFlattenedFlattenFieldUser(b []byte, v *string) error {
+	var wrapper __flattenedFlattenFieldUser
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Name
+	return err
+}
+
+func __marshalFlattenedFlattenFieldUser(v *string) ([]byte, error) {
+	return json.Marshal(&__flattenedFlattenFieldUser{Name: *v})
+}
+
+// __flattenedFlattenFieldUsersUser is used internally by genqlient to (un)marshal the flattened field lastContent.
+type __flattenedFlattenFieldUsersUser struct {
+	LastContent FlattenFieldUsersUserLastContentLeafContent `json:"-"`
+}
+
+// GetLastContent returns __flattenedFlattenFieldUsersUser.LastContent, and is useful for accessing the field via an interface.
+func (v *__flattenedFlattenFieldUsersUser) GetLastContent() FlattenFieldUsersUserLastContentLeafContent {
+	return v.LastContent
+}
+
+func (v *__flattenedFlattenFieldUsersUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*__flattenedFlattenFieldUsersUser
+		LastContent json.RawMessage `json:"lastContent"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.__flattenedFlattenFieldUsersUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.LastContent
+		src := firstPass.LastContent
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenFieldUsersUserLastContentLeafContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal __flattenedFlattenFieldUsersUser.LastContent: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshal__flattenedFlattenFieldUsersUser struct {
+	LastContent json.RawMessage `json:"lastContent"`
+}
+
+func (v *__flattenedFlattenFieldUsersUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *__flattenedFlattenFieldUsersUser) __premarshalJSON() (*__premarshal__flattenedFlattenFieldUsersUser, error) {
+	var retval __premarshal__flattenedFlattenFieldUsersUser
+
+	{
+
+		dst := &retval.LastContent
+		src := v.LastContent
+		var err error
+		*dst, err = __marshalFlattenFieldUsersUserLastContentLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal __flattenedFlattenFieldUsersUser.LastContent: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+func __unmarshalFlattenedFlattenFieldUsersUser(b []byte, v *FlattenFieldUsersUserLastContentLeafContent) error {
+	var wrapper __flattenedFlattenFieldUsersUser
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.LastContent
+	return err
+}
+
+func __marshalFlattenedFlattenFieldUsersUser(v *FlattenFieldUsersUserLastContentLeafContent) ([]byte, error) {
+	return json.Marshal(&__flattenedFlattenFieldUsersUser{LastContent: *v})
+}
+
+// The query executed by FlattenField.
+const FlattenField_Operation = `
+query FlattenField {
+	user {
+		name
+	}
+	users {
+		lastContent {
+			__typename
+			... on Video {
+				duration
+			}
+		}
+	}
+	randomItem {
+		__typename
+		id
+	}
+	randomVideo {
+		parent {
+			... ContentFields
+		}
+	}
+	otherUser: user {
+		emails
+	}
+}
+fragment ContentFields on Content {
+	name
+}
+`
+
+// The SHA-256 hash of FlattenField_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const FlattenField_OperationHash = "00ea430653ac1e80572e1ad501fa87304dcf0d996dbf4fed44654da4b3b45417"
+
+func FlattenField(
+	client_ graphql.Client,
+) (data_ *FlattenFieldResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "FlattenField",
+		Query:  FlattenField_Operation,
+		Hash:   FlattenField_OperationHash,
+	}
+
+	data_ = &FlattenFieldResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
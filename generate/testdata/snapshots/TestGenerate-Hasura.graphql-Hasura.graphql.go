@@ -91,6 +91,10 @@ query GetPokemon ($where: getPokemonBoolExp!) {
 }
 `
 
+// The SHA-256 hash of GetPokemon_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const GetPokemon_OperationHash = "8ade09214988e27fccf6e751a6ec1406bae57017ad9b6fa327f520bd22edc8fd"
+
 func GetPokemon(
 	client_ graphql.Client,
 	where *GetPokemonBoolExp,
@@ -98,6 +102,7 @@ func GetPokemon(
 	req_ := &graphql.Request{
 		OpName: "GetPokemon",
 		Query:  GetPokemon_Operation,
+		Hash:   GetPokemon_OperationHash,
 		Variables: &__GetPokemonInput{
 			Where: where,
 		},
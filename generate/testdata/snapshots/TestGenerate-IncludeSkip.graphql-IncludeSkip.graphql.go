@@ -0,0 +1,712 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// IncludeSkipAlwaysRootTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipAlwaysRootTopic struct {
+	// ID is documented in the Content interface.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns IncludeSkipAlwaysRootTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipAlwaysRootTopic) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipOptionalVideo includes the requested fields of the GraphQL type Video.
+type IncludeSkipOptionalVideo struct {
+	// ID is documented in the Content interface.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns IncludeSkipOptionalVideo.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipOptionalVideo) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipRandomItemArticle includes the requested fields of the GraphQL type Article.
+type IncludeSkipRandomItemArticle struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id     testutil.ID                             `json:"id"`
+	Text   string                                  `json:"text"`
+	Parent IncludeSkipRandomItemArticleParentTopic `json:"parent"`
+}
+
+// GetTypename returns IncludeSkipRandomItemArticle.Typename, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemArticle) GetTypename() string { return v.Typename }
+
+// GetId returns IncludeSkipRandomItemArticle.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemArticle) GetId() testutil.ID { return v.Id }
+
+// GetText returns IncludeSkipRandomItemArticle.Text, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemArticle) GetText() string { return v.Text }
+
+// GetParent returns IncludeSkipRandomItemArticle.Parent, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemArticle) GetParent() IncludeSkipRandomItemArticleParentTopic {
+	return v.Parent
+}
+
+// IncludeSkipRandomItemArticleParentTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipRandomItemArticleParentTopic struct {
+	// ID is documented in the Content interface.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns IncludeSkipRandomItemArticleParentTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemArticleParentTopic) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipRandomItemContent includes the requested fields of the GraphQL interface Content.
+//
+// IncludeSkipRandomItemContent is implemented by the following types:
+// IncludeSkipRandomItemArticle
+// IncludeSkipRandomItemTopic
+// IncludeSkipRandomItemVideo
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type IncludeSkipRandomItemContent interface {
+	implementsGraphQLInterfaceIncludeSkipRandomItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// ID is the identifier of the content.
+	GetId() testutil.ID
+}
+
+func (v *IncludeSkipRandomItemArticle) implementsGraphQLInterfaceIncludeSkipRandomItemContent() {}
+func (v *IncludeSkipRandomItemTopic) implementsGraphQLInterfaceIncludeSkipRandomItemContent()   {}
+func (v *IncludeSkipRandomItemVideo) implementsGraphQLInterfaceIncludeSkipRandomItemContent()   {}
+
+func __unmarshalIncludeSkipRandomItemContent(b []byte, v *IncludeSkipRandomItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(IncludeSkipRandomItemArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(IncludeSkipRandomItemTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(IncludeSkipRandomItemVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for IncludeSkipRandomItemContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalIncludeSkipRandomItemContent(v *IncludeSkipRandomItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *IncludeSkipRandomItemArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IncludeSkipRandomItemArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *IncludeSkipRandomItemTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IncludeSkipRandomItemTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *IncludeSkipRandomItemVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*IncludeSkipRandomItemVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for IncludeSkipRandomItemContent: "%T"`, v)
+	}
+}
+
+// IncludeSkipRandomItemTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipRandomItemTopic struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetTypename returns IncludeSkipRandomItemTopic.Typename, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemTopic) GetTypename() string { return v.Typename }
+
+// GetId returns IncludeSkipRandomItemTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemTopic) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipRandomItemVideo includes the requested fields of the GraphQL type Video.
+type IncludeSkipRandomItemVideo struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetTypename returns IncludeSkipRandomItemVideo.Typename, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemVideo) GetTypename() string { return v.Typename }
+
+// GetId returns IncludeSkipRandomItemVideo.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomItemVideo) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipRandomVideo includes the requested fields of the GraphQL type Video.
+type IncludeSkipRandomVideo struct {
+	// ID is documented in the Content interface.
+	Id                     testutil.ID `json:"id"`
+	IncludeSkipVideoFields `json:"-"`
+}
+
+// GetId returns IncludeSkipRandomVideo.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomVideo) GetId() testutil.ID { return v.Id }
+
+// GetDuration returns IncludeSkipRandomVideo.Duration, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomVideo) GetDuration() int { return v.IncludeSkipVideoFields.Duration }
+
+// GetParent returns IncludeSkipRandomVideo.Parent, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRandomVideo) GetParent() IncludeSkipVideoFieldsParentTopic {
+	return v.IncludeSkipVideoFields.Parent
+}
+
+func (v *IncludeSkipRandomVideo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncludeSkipRandomVideo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncludeSkipRandomVideo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IncludeSkipVideoFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIncludeSkipRandomVideo struct {
+	Id testutil.ID `json:"id"`
+
+	Duration int `json:"duration"`
+
+	Parent IncludeSkipVideoFieldsParentTopic `json:"parent"`
+}
+
+func (v *IncludeSkipRandomVideo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncludeSkipRandomVideo) __premarshalJSON() (*__premarshalIncludeSkipRandomVideo, error) {
+	var retval __premarshalIncludeSkipRandomVideo
+
+	retval.Id = v.Id
+	retval.Duration = v.IncludeSkipVideoFields.Duration
+	retval.Parent = v.IncludeSkipVideoFields.Parent
+	return &retval, nil
+}
+
+// IncludeSkipRequiredRootTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipRequiredRootTopic struct {
+	// ID is documented in the Content interface.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns IncludeSkipRequiredRootTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRequiredRootTopic) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipResponse is returned by IncludeSkip on success.
+type IncludeSkipResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User          IncludeSkipUser              `json:"user"`
+	Root          IncludeSkipRootTopic         `json:"root"`
+	AlwaysRoot    IncludeSkipAlwaysRootTopic   `json:"alwaysRoot"`
+	RandomItem    IncludeSkipRandomItemContent `json:"-"`
+	RequiredRoot  IncludeSkipRequiredRootTopic `json:"requiredRoot"`
+	OptionalVideo IncludeSkipOptionalVideo     `json:"optionalVideo"`
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	Viewer      IncludeSkipViewerUser  `json:"viewer"`
+	RandomVideo IncludeSkipRandomVideo `json:"randomVideo"`
+}
+
+// GetUser returns IncludeSkipResponse.User, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetUser() IncludeSkipUser { return v.User }
+
+// GetRoot returns IncludeSkipResponse.Root, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetRoot() IncludeSkipRootTopic { return v.Root }
+
+// GetAlwaysRoot returns IncludeSkipResponse.AlwaysRoot, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetAlwaysRoot() IncludeSkipAlwaysRootTopic { return v.AlwaysRoot }
+
+// GetRandomItem returns IncludeSkipResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetRandomItem() IncludeSkipRandomItemContent { return v.RandomItem }
+
+// GetRequiredRoot returns IncludeSkipResponse.RequiredRoot, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetRequiredRoot() IncludeSkipRequiredRootTopic { return v.RequiredRoot }
+
+// GetOptionalVideo returns IncludeSkipResponse.OptionalVideo, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetOptionalVideo() IncludeSkipOptionalVideo { return v.OptionalVideo }
+
+// GetViewer returns IncludeSkipResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetViewer() IncludeSkipViewerUser { return v.Viewer }
+
+// GetRandomVideo returns IncludeSkipResponse.RandomVideo, and is useful for accessing the field via an interface.
+func (v *IncludeSkipResponse) GetRandomVideo() IncludeSkipRandomVideo { return v.RandomVideo }
+
+func (v *IncludeSkipResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncludeSkipResponse
+		RandomItem json.RawMessage `json:"randomItem"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncludeSkipResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RandomItem
+		src := firstPass.RandomItem
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalIncludeSkipRandomItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal IncludeSkipResponse.RandomItem: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalIncludeSkipResponse struct {
+	User IncludeSkipUser `json:"user"`
+
+	Root IncludeSkipRootTopic `json:"root"`
+
+	AlwaysRoot IncludeSkipAlwaysRootTopic `json:"alwaysRoot"`
+
+	RandomItem json.RawMessage `json:"randomItem"`
+
+	RequiredRoot IncludeSkipRequiredRootTopic `json:"requiredRoot"`
+
+	OptionalVideo IncludeSkipOptionalVideo `json:"optionalVideo"`
+
+	Viewer IncludeSkipViewerUser `json:"viewer"`
+
+	RandomVideo IncludeSkipRandomVideo `json:"randomVideo"`
+}
+
+func (v *IncludeSkipResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncludeSkipResponse) __premarshalJSON() (*__premarshalIncludeSkipResponse, error) {
+	var retval __premarshalIncludeSkipResponse
+
+	retval.User = v.User
+	retval.Root = v.Root
+	retval.AlwaysRoot = v.AlwaysRoot
+	{
+
+		dst := &retval.RandomItem
+		src := v.RandomItem
+		var err error
+		*dst, err = __marshalIncludeSkipRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal IncludeSkipResponse.RandomItem: %w", err)
+		}
+	}
+	retval.RequiredRoot = v.RequiredRoot
+	retval.OptionalVideo = v.OptionalVideo
+	retval.Viewer = v.Viewer
+	retval.RandomVideo = v.RandomVideo
+	return &retval, nil
+}
+
+// IncludeSkipRootTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipRootTopic struct {
+	// ID is documented in the Content interface.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+}
+
+// GetId returns IncludeSkipRootTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRootTopic) GetId() testutil.ID { return v.Id }
+
+// GetName returns IncludeSkipRootTopic.Name, and is useful for accessing the field via an interface.
+func (v *IncludeSkipRootTopic) GetName() string { return v.Name }
+
+// IncludeSkipUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type IncludeSkipUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id     testutil.ID `json:"id"`
+	Name   string      `json:"name"`
+	Emails []string    `json:"emails"`
+}
+
+// GetId returns IncludeSkipUser.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUser) GetId() testutil.ID { return v.Id }
+
+// GetName returns IncludeSkipUser.Name, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUser) GetName() string { return v.Name }
+
+// GetEmails returns IncludeSkipUser.Emails, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUser) GetEmails() []string { return v.Emails }
+
+// IncludeSkipUserFields includes the GraphQL fields of User requested by the fragment IncludeSkipUserFields.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type IncludeSkipUserFields struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id                  testutil.ID `json:"id"`
+	Emails              []string    `json:"emails"`
+	IncludeSkipUserName `json:"-"`
+}
+
+// GetId returns IncludeSkipUserFields.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserFields) GetId() testutil.ID { return v.Id }
+
+// GetEmails returns IncludeSkipUserFields.Emails, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserFields) GetEmails() []string { return v.Emails }
+
+// GetName returns IncludeSkipUserFields.Name, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserFields) GetName() string { return v.IncludeSkipUserName.Name }
+
+// GetUser_id returns IncludeSkipUserFields.User_id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserFields) GetUser_id() string { return v.IncludeSkipUserName.User_id }
+
+func (v *IncludeSkipUserFields) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncludeSkipUserFields
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncludeSkipUserFields = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IncludeSkipUserName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIncludeSkipUserFields struct {
+	Id testutil.ID `json:"id"`
+
+	Emails []string `json:"emails"`
+
+	Name string `json:"name"`
+
+	User_id string `json:"user_id"`
+}
+
+func (v *IncludeSkipUserFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncludeSkipUserFields) __premarshalJSON() (*__premarshalIncludeSkipUserFields, error) {
+	var retval __premarshalIncludeSkipUserFields
+
+	retval.Id = v.Id
+	retval.Emails = v.Emails
+	retval.Name = v.IncludeSkipUserName.Name
+	retval.User_id = v.IncludeSkipUserName.User_id
+	return &retval, nil
+}
+
+// IncludeSkipUserName includes the GraphQL fields of User requested by the fragment IncludeSkipUserName.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type IncludeSkipUserName struct {
+	Name    string `json:"name"`
+	User_id string `json:"user_id"`
+}
+
+// GetName returns IncludeSkipUserName.Name, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserName) GetName() string { return v.Name }
+
+// GetUser_id returns IncludeSkipUserName.User_id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipUserName) GetUser_id() string { return v.User_id }
+
+// IncludeSkipVideoFields includes the GraphQL fields of Video requested by the fragment IncludeSkipVideoFields.
+type IncludeSkipVideoFields struct {
+	Duration int                               `json:"duration"`
+	Parent   IncludeSkipVideoFieldsParentTopic `json:"parent"`
+}
+
+// GetDuration returns IncludeSkipVideoFields.Duration, and is useful for accessing the field via an interface.
+func (v *IncludeSkipVideoFields) GetDuration() int { return v.Duration }
+
+// GetParent returns IncludeSkipVideoFields.Parent, and is useful for accessing the field via an interface.
+func (v *IncludeSkipVideoFields) GetParent() IncludeSkipVideoFieldsParentTopic { return v.Parent }
+
+// IncludeSkipVideoFieldsParentTopic includes the requested fields of the GraphQL type Topic.
+type IncludeSkipVideoFieldsParentTopic struct {
+	// ID is documented in the Content interface.
+	Id testutil.ID `json:"id"`
+}
+
+// GetId returns IncludeSkipVideoFieldsParentTopic.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipVideoFieldsParentTopic) GetId() testutil.ID { return v.Id }
+
+// IncludeSkipViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type IncludeSkipViewerUser struct {
+	IncludeSkipUserFields `json:"-"`
+}
+
+// GetId returns IncludeSkipViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipViewerUser) GetId() testutil.ID { return v.IncludeSkipUserFields.Id }
+
+// GetEmails returns IncludeSkipViewerUser.Emails, and is useful for accessing the field via an interface.
+func (v *IncludeSkipViewerUser) GetEmails() []string { return v.IncludeSkipUserFields.Emails }
+
+// GetName returns IncludeSkipViewerUser.Name, and is useful for accessing the field via an interface.
+func (v *IncludeSkipViewerUser) GetName() string {
+	return v.IncludeSkipUserFields.IncludeSkipUserName.Name
+}
+
+// GetUser_id returns IncludeSkipViewerUser.User_id, and is useful for accessing the field via an interface.
+func (v *IncludeSkipViewerUser) GetUser_id() string {
+	return v.IncludeSkipUserFields.IncludeSkipUserName.User_id
+}
+
+func (v *IncludeSkipViewerUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IncludeSkipViewerUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IncludeSkipViewerUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IncludeSkipUserFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIncludeSkipViewerUser struct {
+	Id testutil.ID `json:"id"`
+
+	Emails []string `json:"emails"`
+
+	Name string `json:"name"`
+
+	User_id string `json:"user_id"`
+}
+
+func (v *IncludeSkipViewerUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IncludeSkipViewerUser) __premarshalJSON() (*__premarshalIncludeSkipViewerUser, error) {
+	var retval __premarshalIncludeSkipViewerUser
+
+	retval.Id = v.IncludeSkipUserFields.Id
+	retval.Emails = v.IncludeSkipUserFields.Emails
+	retval.Name = v.IncludeSkipUserFields.IncludeSkipUserName.Name
+	retval.User_id = v.IncludeSkipUserFields.IncludeSkipUserName.User_id
+	return &retval, nil
+}
+
+// __IncludeSkipInput is used internally by genqlient
+type __IncludeSkipInput struct {
+	WithDetails bool `json:"withDetails"`
+	SkipRoot    bool `json:"skipRoot"`
+}
+
+// GetWithDetails returns __IncludeSkipInput.WithDetails, and is useful for accessing the field via an interface.
+func (v *__IncludeSkipInput) GetWithDetails() bool { return v.WithDetails }
+
+// GetSkipRoot returns __IncludeSkipInput.SkipRoot, and is useful for accessing the field via an interface.
+func (v *__IncludeSkipInput) GetSkipRoot() bool { return v.SkipRoot }
+
+// The query executed by IncludeSkip.
+const IncludeSkip_Operation = `
+query IncludeSkip ($withDetails: Boolean!, $skipRoot: Boolean!) {
+	user {
+		id
+		name @include(if: $withDetails)
+		emails @include(if: $withDetails)
+	}
+	root @skip(if: $skipRoot) {
+		id
+		name
+	}
+	alwaysRoot: root @include(if: true) {
+		id
+	}
+	randomItem {
+		__typename
+		id
+		... on Article @include(if: $withDetails) {
+			text
+			parent {
+				id
+			}
+		}
+	}
+	requiredRoot: root @skip(if: $skipRoot) {
+		id
+	}
+	optionalVideo: randomVideo {
+		id
+	}
+	viewer: user {
+		... IncludeSkipUserFields @include(if: $withDetails)
+	}
+	randomVideo {
+		id
+		... @skip(if: $skipRoot) {
+			... IncludeSkipVideoFields
+		}
+	}
+}
+fragment IncludeSkipUserFields on User {
+	id
+	emails
+	... IncludeSkipUserName
+}
+fragment IncludeSkipVideoFields on Video {
+	duration
+	parent {
+		id
+	}
+}
+fragment IncludeSkipUserName on User {
+	name
+	user_id
+}
+`
+
+// The SHA-256 hash of IncludeSkip_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const IncludeSkip_OperationHash = "3a71261d718397d7ad1b8741189a8c4fc3333afe5d59bdc64d754ef1884531f2"
+
+func IncludeSkip(
+	client_ graphql.Client,
+	withDetails bool,
+	skipRoot bool,
+) (data_ *IncludeSkipResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "IncludeSkip",
+		Query:  IncludeSkip_Operation,
+		Hash:   IncludeSkip_OperationHash,
+		Variables: &__IncludeSkipInput{
+			WithDetails: withDetails,
+			SkipRoot:    skipRoot,
+		},
+	}
+
+	data_ = &IncludeSkipResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
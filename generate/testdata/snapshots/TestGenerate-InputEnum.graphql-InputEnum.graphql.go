@@ -51,6 +51,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // __InputEnumQueryInput is used internally by genqlient
 type __InputEnumQueryInput struct {
 	Role Role `json:"role"`
@@ -68,6 +91,10 @@ query InputEnumQuery ($role: Role!) {
 }
 `
 
+// The SHA-256 hash of InputEnumQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InputEnumQuery_OperationHash = "2c1ddbd6235b8d379ecf482d08ce9c2008e78284fdac86efaff30895d3c4b297"
+
 func InputEnumQuery(
 	client_ graphql.Client,
 	role Role,
@@ -75,6 +102,7 @@ func InputEnumQuery(
 	req_ := &graphql.Request{
 		OpName: "InputEnumQuery",
 		Query:  InputEnumQuery_Operation,
+		Hash:   InputEnumQuery_OperationHash,
 		Variables: &__InputEnumQueryInput{
 			Role: role,
 		},
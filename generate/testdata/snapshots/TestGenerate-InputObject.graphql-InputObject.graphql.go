@@ -56,6 +56,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -191,6 +214,10 @@ query InputObjectQuery ($query: UserQueryInput) {
 }
 `
 
+// The SHA-256 hash of InputObjectQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InputObjectQuery_OperationHash = "e014b248f8fbb4c1c1af234caddac3ccac15e406513056c9f2461089c87d9716"
+
 func InputObjectQuery(
 	client_ graphql.Client,
 	query UserQueryInput,
@@ -198,6 +225,7 @@ func InputObjectQuery(
 	req_ := &graphql.Request{
 		OpName: "InputObjectQuery",
 		Query:  InputObjectQuery_Operation,
+		Hash:   InputObjectQuery_OperationHash,
 		Variables: &__InputObjectQueryInput{
 			Query: query,
 		},
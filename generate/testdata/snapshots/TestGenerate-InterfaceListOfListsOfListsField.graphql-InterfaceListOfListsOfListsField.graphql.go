@@ -522,12 +522,17 @@ query InterfaceListOfListOfListsField {
 }
 `
 
+// The SHA-256 hash of InterfaceListOfListOfListsField_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InterfaceListOfListOfListsField_OperationHash = "a56baea97fa9125de4ea4438c2fe30c1d71c6f940577d7d14af6100b9f13c655"
+
 func InterfaceListOfListOfListsField(
 	client_ graphql.Client,
 ) (data_ *InterfaceListOfListOfListsFieldResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "InterfaceListOfListOfListsField",
 		Query:  InterfaceListOfListOfListsField_Operation,
+		Hash:   InterfaceListOfListOfListsField_OperationHash,
 	}
 
 	data_ = &InterfaceListOfListOfListsFieldResponse{}
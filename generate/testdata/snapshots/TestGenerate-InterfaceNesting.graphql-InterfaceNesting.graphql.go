@@ -524,12 +524,17 @@ query InterfaceNesting {
 }
 `
 
+// The SHA-256 hash of InterfaceNesting_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InterfaceNesting_OperationHash = "3898498a460d7e4b7aa442927f844f0bd1500b6ffa9e6f14271ebcf2dfccc698"
+
 func InterfaceNesting(
 	client_ graphql.Client,
 ) (data_ *InterfaceNestingResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "InterfaceNesting",
 		Query:  InterfaceNesting_Operation,
+		Hash:   InterfaceNesting_OperationHash,
 	}
 
 	data_ = &InterfaceNestingResponse{}
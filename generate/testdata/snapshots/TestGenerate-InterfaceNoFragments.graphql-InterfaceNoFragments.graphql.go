@@ -651,12 +651,17 @@ query InterfaceNoFragmentsQuery {
 }
 `
 
+// The SHA-256 hash of InterfaceNoFragmentsQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InterfaceNoFragmentsQuery_OperationHash = "9e63da8d4cb4b550f8a6526e76c5fe6449148273c86868d08df8d121bc3504ba"
+
 func InterfaceNoFragmentsQuery(
 	client_ graphql.Client,
 ) (data_ *InterfaceNoFragmentsQueryResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "InterfaceNoFragmentsQuery",
 		Query:  InterfaceNoFragmentsQuery_Operation,
+		Hash:   InterfaceNoFragmentsQuery_OperationHash,
 	}
 
 	data_ = &InterfaceNoFragmentsQueryResponse{}
@@ -50,6 +50,10 @@ query ListInputQuery ($names: [String]) {
 }
 `
 
+// The SHA-256 hash of ListInputQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ListInputQuery_OperationHash = "426daf556301f116c01dc169d6a3f11479f2a4bd6c1b3a08002d8f06d3642746"
+
 func ListInputQuery(
 	client_ graphql.Client,
 	names []string,
@@ -57,6 +61,7 @@ func ListInputQuery(
 	req_ := &graphql.Request{
 		OpName: "ListInputQuery",
 		Query:  ListInputQuery_Operation,
+		Hash:   ListInputQuery_OperationHash,
 		Variables: &__ListInputQueryInput{
 			Names: names,
 		},
@@ -50,6 +50,10 @@ query ListInputOmitemptyQuery ($names: [String!]) {
 }
 `
 
+// The SHA-256 hash of ListInputOmitemptyQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ListInputOmitemptyQuery_OperationHash = "8c44f0e0a35f007c0f7d39033a7218beb41dc424916a8af447911477ba7385dd"
+
 func ListInputOmitemptyQuery(
 	client_ graphql.Client,
 	names []string,
@@ -57,6 +61,7 @@ func ListInputOmitemptyQuery(
 	req_ := &graphql.Request{
 		OpName: "ListInputOmitemptyQuery",
 		Query:  ListInputOmitemptyQuery_Operation,
+		Hash:   ListInputOmitemptyQuery_OperationHash,
 		Variables: &__ListInputOmitemptyQueryInput{
 			Names: names,
 		},
@@ -23,12 +23,17 @@ query ListOfListsOfLists {
 }
 `
 
+// The SHA-256 hash of ListOfListsOfLists_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ListOfListsOfLists_OperationHash = "14a0628c4267feb2af169b86a24b1be520379bb59540fd1bd66796620bace8df"
+
 func ListOfListsOfLists(
 	client_ graphql.Client,
 ) (data_ *ListOfListsOfListsResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "ListOfListsOfLists",
 		Query:  ListOfListsOfLists_Operation,
+		Hash:   ListOfListsOfLists_OperationHash,
 	}
 
 	data_ = &ListOfListsOfListsResponse{}
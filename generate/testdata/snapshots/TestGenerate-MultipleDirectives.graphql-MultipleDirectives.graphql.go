@@ -197,6 +197,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -342,6 +365,10 @@ query MultipleDirectives ($query: UserQueryInput, $queries: [UserQueryInput]) {
 }
 `
 
+// The SHA-256 hash of MultipleDirectives_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const MultipleDirectives_OperationHash = "ff4be913bde52e0f5a7646752695f82f0bd5c5418e16e6447eac3aec08529995"
+
 func MultipleDirectives(
 	client_ graphql.Client,
 	query MyInput,
@@ -350,6 +377,7 @@ func MultipleDirectives(
 	req_ := &graphql.Request{
 		OpName: "MultipleDirectives",
 		Query:  MultipleDirectives_Operation,
+		Hash:   MultipleDirectives_OperationHash,
 		Variables: &__MultipleDirectivesInput{
 			Query:   query,
 			Queries: queries,
@@ -60,6 +60,10 @@ mutation MutationArgsWithCollidingNames ($data: String!, $req: Int, $resp: Int,
 }
 `
 
+// The SHA-256 hash of MutationArgsWithCollidingNames_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const MutationArgsWithCollidingNames_OperationHash = "708640df257e9ce47660f48fe4f62257eef21c24042866e6a41f575dd3486104"
+
 func MutationArgsWithCollidingNames(
 	client_ graphql.Client,
 	data string,
@@ -70,6 +74,7 @@ func MutationArgsWithCollidingNames(
 	req_ := &graphql.Request{
 		OpName: "MutationArgsWithCollidingNames",
 		Query:  MutationArgsWithCollidingNames_Operation,
+		Hash:   MutationArgsWithCollidingNames_OperationHash,
 		Variables: &__MutationArgsWithCollidingNamesInput{
 			Data:   data,
 			Req:    req,
@@ -82,6 +82,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -238,6 +261,10 @@ query OmitEmptyQuery ($query: UserQueryInput, $queries: [UserQueryInput], $dt: D
 }
 `
 
+// The SHA-256 hash of OmitEmptyQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const OmitEmptyQuery_OperationHash = "0b1d1ed1d5cac96c5c5ae37cf81e32daf6b41b81b292efd8cc334c3c22d43e38"
+
 func OmitEmptyQuery(
 	client_ graphql.Client,
 	query UserQueryInput,
@@ -249,6 +276,7 @@ func OmitEmptyQuery(
 	req_ := &graphql.Request{
 		OpName: "OmitEmptyQuery",
 		Query:  OmitEmptyQuery_Operation,
+		Hash:   OmitEmptyQuery_OperationHash,
 		Variables: &__OmitEmptyQueryInput{
 			Query:         query,
 			Queries:       queries,
@@ -40,6 +40,10 @@ query OmitemptyFalse ($input: OmitemptyInput) {
 }
 `
 
+// The SHA-256 hash of OmitemptyFalse_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const OmitemptyFalse_OperationHash = "1dd9a958c616857c32e74315ba15ad044cab616a5d80e23356c0853101c4d302"
+
 func OmitemptyFalse(
 	client_ graphql.Client,
 	input OmitemptyInput,
@@ -47,6 +51,7 @@ func OmitemptyFalse(
 	req_ := &graphql.Request{
 		OpName: "OmitemptyFalse",
 		Query:  OmitemptyFalse_Operation,
+		Hash:   OmitemptyFalse_OperationHash,
 		Variables: &__OmitemptyFalseInput{
 			Input: input,
 		},
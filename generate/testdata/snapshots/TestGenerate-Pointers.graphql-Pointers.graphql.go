@@ -98,6 +98,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -252,6 +275,10 @@ query PointersQuery ($query: UserQueryInput, $dt: DateTime, $tz: String) {
 }
 `
 
+// The SHA-256 hash of PointersQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const PointersQuery_OperationHash = "dcc908c6d389c40f88997f15b05db6fbf79cc0f75c3858b1f22cf4e0ad2c17f1"
+
 func PointersQuery(
 	client_ graphql.Client,
 	query *UserQueryInput,
@@ -261,6 +288,7 @@ func PointersQuery(
 	req_ := &graphql.Request{
 		OpName: "PointersQuery",
 		Query:  PointersQuery_Operation,
+		Hash:   PointersQuery_OperationHash,
 		Variables: &__PointersQueryInput{
 			Query: query,
 			Dt:    dt,
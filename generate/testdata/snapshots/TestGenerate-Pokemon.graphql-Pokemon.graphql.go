@@ -90,6 +90,10 @@ query GetPokemonSiblings ($input: PokemonInput!) {
 }
 `
 
+// The SHA-256 hash of GetPokemonSiblings_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const GetPokemonSiblings_OperationHash = "ddca1f33a7e7152077694fd5cc75eeedff31c977c4961b80c1591d4db74696bc"
+
 func GetPokemonSiblings(
 	client_ graphql.Client,
 	input testutil.Pokemon,
@@ -97,6 +101,7 @@ func GetPokemonSiblings(
 	req_ := &graphql.Request{
 		OpName: "GetPokemonSiblings",
 		Query:  GetPokemonSiblings_Operation,
+		Hash:   GetPokemonSiblings_OperationHash,
 		Variables: &__GetPokemonSiblingsInput{
 			Input: input,
 		},
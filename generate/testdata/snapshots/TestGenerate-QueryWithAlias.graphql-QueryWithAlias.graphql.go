@@ -50,12 +50,17 @@ query QueryWithAlias {
 }
 `
 
+// The SHA-256 hash of QueryWithAlias_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithAlias_OperationHash = "ea56e7bf45ac404258ecc57ae013717f055545da612f485517e59ca8649e4e6a"
+
 func QueryWithAlias(
 	client_ graphql.Client,
 ) (data_ *QueryWithAliasResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "QueryWithAlias",
 		Query:  QueryWithAlias_Operation,
+		Hash:   QueryWithAlias_OperationHash,
 	}
 
 	data_ = &QueryWithAliasResponse{}
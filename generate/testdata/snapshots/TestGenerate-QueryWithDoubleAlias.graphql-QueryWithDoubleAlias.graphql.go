@@ -50,12 +50,17 @@ query QueryWithDoubleAlias {
 }
 `
 
+// The SHA-256 hash of QueryWithDoubleAlias_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithDoubleAlias_OperationHash = "82428dec723090025f069f0f2c4d70ea0b77d693cfd7d10471bd7b2a1c28836b"
+
 func QueryWithDoubleAlias(
 	client_ graphql.Client,
 ) (data_ *QueryWithDoubleAliasResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "QueryWithDoubleAlias",
 		Query:  QueryWithDoubleAlias_Operation,
+		Hash:   QueryWithDoubleAlias_OperationHash,
 	}
 
 	data_ = &QueryWithDoubleAliasResponse{}
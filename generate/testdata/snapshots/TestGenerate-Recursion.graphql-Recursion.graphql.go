@@ -83,6 +83,10 @@ query Recursion ($input: RecursiveInput!) {
 }
 `
 
+// The SHA-256 hash of Recursion_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const Recursion_OperationHash = "df909aae5eb8934ed58a5f8a61ecfaddadaf22f4951bc4533980553a1f9e3580"
+
 func Recursion(
 	client_ graphql.Client,
 	input RecursiveInput,
@@ -90,6 +94,7 @@ func Recursion(
 	req_ := &graphql.Request{
 		OpName: "Recursion",
 		Query:  Recursion_Operation,
+		Hash:   Recursion_OperationHash,
 		Variables: &__RecursionInput{
 			Input: input,
 		},
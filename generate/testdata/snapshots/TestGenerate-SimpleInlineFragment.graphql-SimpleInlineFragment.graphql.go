@@ -256,12 +256,17 @@ query SimpleInlineFragment {
 }
 `
 
+// The SHA-256 hash of SimpleInlineFragment_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleInlineFragment_OperationHash = "74da2e6987b9c106786a8d139c710cd1a2f614bf78dd8498ce4967867eb22d28"
+
 func SimpleInlineFragment(
 	client_ graphql.Client,
 ) (data_ *SimpleInlineFragmentResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SimpleInlineFragment",
 		Query:  SimpleInlineFragment_Operation,
+		Hash:   SimpleInlineFragment_OperationHash,
 	}
 
 	data_ = &SimpleInlineFragmentResponse{}
@@ -50,6 +50,10 @@ query SimpleInputQuery ($name: String!) {
 }
 `
 
+// The SHA-256 hash of SimpleInputQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleInputQuery_OperationHash = "84ce0c464ccde445c4d1597dd7517a3fe6d4b520f7ddfbf3909ea28bef900cde"
+
 func SimpleInputQuery(
 	client_ graphql.Client,
 	name string,
@@ -57,6 +61,7 @@ func SimpleInputQuery(
 	req_ := &graphql.Request{
 		OpName: "SimpleInputQuery",
 		Query:  SimpleInputQuery_Operation,
+		Hash:   SimpleInputQuery_OperationHash,
 		Variables: &__SimpleInputQueryInput{
 			Name: name,
 		},
@@ -51,6 +51,10 @@ mutation SimpleMutation ($name: String!) {
 }
 `
 
+// The SHA-256 hash of SimpleMutation_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleMutation_OperationHash = "560dcb2261471cee26fc98a42a3e1e3547d87470bf2979b200b178803b0fdc09"
+
 // SimpleMutation creates a user.
 //
 // It has a long doc-comment, to test that we handle that correctly.
@@ -62,6 +66,7 @@ func SimpleMutation(
 	req_ := &graphql.Request{
 		OpName: "SimpleMutation",
 		Query:  SimpleMutation_Operation,
+		Hash:   SimpleMutation_OperationHash,
 		Variables: &__SimpleMutationInput{
 			Name: name,
 		},
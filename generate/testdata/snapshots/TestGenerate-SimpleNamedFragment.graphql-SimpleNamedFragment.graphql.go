@@ -571,12 +571,17 @@ fragment VideoFields on Video {
 }
 `
 
+// The SHA-256 hash of SimpleNamedFragment_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleNamedFragment_OperationHash = "e032b6f75826bba2f7581d052d14841bb283d128de1d3a93871299b749c82b64"
+
 func SimpleNamedFragment(
 	client_ graphql.Client,
 ) (data_ *SimpleNamedFragmentResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SimpleNamedFragment",
 		Query:  SimpleNamedFragment_Operation,
+		Hash:   SimpleNamedFragment_OperationHash,
 	}
 
 	data_ = &SimpleNamedFragmentResponse{}
@@ -49,12 +49,17 @@ query SimpleQueryWithPointerFalseOverride {
 }
 `
 
+// The SHA-256 hash of SimpleQueryWithPointerFalseOverride_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleQueryWithPointerFalseOverride_OperationHash = "29e87ff9bf7fe302e5d143ede9b7be1eb9a3770ce2fd2bb3edd76593de2b981a"
+
 func SimpleQueryWithPointerFalseOverride(
 	client_ graphql.Client,
 ) (data_ *SimpleQueryWithPointerFalseOverrideResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SimpleQueryWithPointerFalseOverride",
 		Query:  SimpleQueryWithPointerFalseOverride_Operation,
+		Hash:   SimpleQueryWithPointerFalseOverride_OperationHash,
 	}
 
 	data_ = &SimpleQueryWithPointerFalseOverrideResponse{}
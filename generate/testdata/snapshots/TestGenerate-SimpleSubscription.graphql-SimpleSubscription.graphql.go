@@ -24,6 +24,10 @@ subscription SimpleSubscription {
 }
 `
 
+// The SHA-256 hash of SimpleSubscription_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleSubscription_OperationHash = "f1eaa4d1b1c2eded77f800897dcdff0063d51c85e7edce8e93cff86c25e7649c"
+
 // To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
 func SimpleSubscription(
 	client_ graphql.WebSocketClient,
@@ -31,6 +35,7 @@ func SimpleSubscription(
 	req_ := &graphql.Request{
 		OpName: "SimpleSubscription",
 		Query:  SimpleSubscription_Operation,
+		Hash:   SimpleSubscription_OperationHash,
 	}
 
 	dataChan_ = make(chan SimpleSubscriptionWsResponse)
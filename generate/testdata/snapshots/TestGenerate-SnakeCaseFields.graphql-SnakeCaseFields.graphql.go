@@ -43,12 +43,17 @@ query SnakeCaseFields {
 }
 `
 
+// The SHA-256 hash of SnakeCaseFields_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SnakeCaseFields_OperationHash = "5b1df6305dc97e969b3925efe64c873558d426e2592fd2e844166fe0e630ac2d"
+
 func SnakeCaseFields(
 	client_ graphql.Client,
 ) (data_ *SnakeCaseFieldsResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SnakeCaseFields",
 		Query:  SnakeCaseFields_Operation,
+		Hash:   SnakeCaseFields_OperationHash,
 	}
 
 	data_ = &SnakeCaseFieldsResponse{}
@@ -53,12 +53,17 @@ query SnakeCaseNested {
 }
 `
 
+// The SHA-256 hash of SnakeCaseNested_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SnakeCaseNested_OperationHash = "784741e7c93aeeff1424367b2cf7a652541bb5c798ce312d1a2c66320fe6100d"
+
 func SnakeCaseNested(
 	client_ graphql.Client,
 ) (data_ *SnakeCaseNestedResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SnakeCaseNested",
 		Query:  SnakeCaseNested_Operation,
+		Hash:   SnakeCaseNested_OperationHash,
 	}
 
 	data_ = &SnakeCaseNestedResponse{}
@@ -29,6 +29,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // StructOptionResponse is returned by StructOption on success.
 type StructOptionResponse struct {
 	Root StructOptionRootTopic `json:"root"`
@@ -460,12 +483,17 @@ fragment VideoFields on Video {
 }
 `
 
+// The SHA-256 hash of StructOption_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const StructOption_OperationHash = "5ab7399fda700c85de8d829193852aa565b8652fbbcd04754ab3ed487aaf302f"
+
 func StructOption(
 	client_ graphql.Client,
 ) (data_ *StructOptionResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "StructOption",
 		Query:  StructOption_Operation,
+		Hash:   StructOption_OperationHash,
 	}
 
 	data_ = &StructOptionResponse{}
@@ -47,12 +47,17 @@ query TypeNameQuery {
 }
 `
 
+// The SHA-256 hash of TypeNameQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const TypeNameQuery_OperationHash = "09a8305acea8d6c2773e088d6249ac26a6a3ab7b8b413f320edd7d8d765bba15"
+
 func TypeNameQuery(
 	client_ graphql.Client,
 ) (data_ *TypeNameQueryResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "TypeNameQuery",
 		Query:  TypeNameQuery_Operation,
+		Hash:   TypeNameQuery_OperationHash,
 	}
 
 	data_ = &TypeNameQueryResponse{}
@@ -0,0 +1,1199 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// ContentFields includes the GraphQL fields of Content requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+//
+// ContentFields is implemented by the following types:
+// ContentFieldsArticle
+// ContentFieldsTopic
+// ContentFieldsVideo
+// ContentFieldsUnknown
+type ContentFields interface {
+	implementsGraphQLInterfaceContentFields()
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// ID is the identifier of the content.
+	GetId() testutil.ID
+	// GetUrl returns the interface-field "url" from its implementation.
+	GetUrl() string
+}
+
+func (v *ContentFieldsArticle) implementsGraphQLInterfaceContentFields() {}
+func (v *ContentFieldsTopic) implementsGraphQLInterfaceContentFields()   {}
+func (v *ContentFieldsVideo) implementsGraphQLInterfaceContentFields()   {}
+func (v *ContentFieldsUnknown) implementsGraphQLInterfaceContentFields() {}
+
+func __unmarshalContentFields(b []byte, v *ContentFields) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(ContentFieldsArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(ContentFieldsTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(ContentFieldsVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		unknown := new(ContentFieldsUnknown)
+		err = json.Unmarshal(b, unknown)
+		if err != nil {
+			return err
+		}
+		unknown.Raw = append(json.RawMessage(nil), b...)
+		*v = unknown
+		return nil
+	}
+}
+
+func __marshalContentFields(v *ContentFields) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ContentFieldsArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContentFieldsTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContentFieldsVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContentFieldsVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContentFieldsUnknown:
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ContentFields: "%T"`, v)
+	}
+}
+
+// ContentFields includes the GraphQL fields of Article requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsArticle struct {
+	// ID is the identifier of the content.
+	Id  testutil.ID `json:"id"`
+	Url string      `json:"url"`
+}
+
+// GetId returns ContentFieldsArticle.Id, and is useful for accessing the field via an interface.
+func (v *ContentFieldsArticle) GetId() testutil.ID { return v.Id }
+
+// GetUrl returns ContentFieldsArticle.Url, and is useful for accessing the field via an interface.
+func (v *ContentFieldsArticle) GetUrl() string { return v.Url }
+
+// ContentFields includes the GraphQL fields of Topic requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsTopic struct {
+	// ID is the identifier of the content.
+	Id  testutil.ID `json:"id"`
+	Url string      `json:"url"`
+}
+
+// GetId returns ContentFieldsTopic.Id, and is useful for accessing the field via an interface.
+func (v *ContentFieldsTopic) GetId() testutil.ID { return v.Id }
+
+// GetUrl returns ContentFieldsTopic.Url, and is useful for accessing the field via an interface.
+func (v *ContentFieldsTopic) GetUrl() string { return v.Url }
+
+// ContentFieldsUnknown is the implementation of ContentFields for GraphQL types which were not known when this code was generated.  It includes the requested fields shared by all types.
+type ContentFieldsUnknown struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id  testutil.ID `json:"id"`
+	Url string      `json:"url"`
+	// Raw is the JSON of the object, as returned by the server.
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTypename returns ContentFieldsUnknown.Typename, and is useful for accessing the field via an interface.
+func (v *ContentFieldsUnknown) GetTypename() string { return v.Typename }
+
+// GetId returns ContentFieldsUnknown.Id, and is useful for accessing the field via an interface.
+func (v *ContentFieldsUnknown) GetId() testutil.ID { return v.Id }
+
+// GetUrl returns ContentFieldsUnknown.Url, and is useful for accessing the field via an interface.
+func (v *ContentFieldsUnknown) GetUrl() string { return v.Url }
+
+// GetRaw returns ContentFieldsUnknown.Raw, and is useful for accessing the field via an interface.
+func (v *ContentFieldsUnknown) GetRaw() json.RawMessage { return v.Raw }
+
+// ContentFields includes the GraphQL fields of Video requested by the fragment ContentFields.
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type ContentFieldsVideo struct {
+	// ID is the identifier of the content.
+	Id  testutil.ID `json:"id"`
+	Url string      `json:"url"`
+}
+
+// GetId returns ContentFieldsVideo.Id, and is useful for accessing the field via an interface.
+func (v *ContentFieldsVideo) GetId() testutil.ID { return v.Id }
+
+// GetUrl returns ContentFieldsVideo.Url, and is useful for accessing the field via an interface.
+func (v *ContentFieldsVideo) GetUrl() string { return v.Url }
+
+// UnknownImplementationsQueryOtherItemArticle includes the requested fields of the GraphQL type Article.
+type UnknownImplementationsQueryOtherItemArticle struct {
+	Typename             string `json:"__typename"`
+	ContentFieldsArticle `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryOtherItemArticle.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemArticle) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryOtherItemArticle.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemArticle) GetId() testutil.ID {
+	return v.ContentFieldsArticle.Id
+}
+
+// GetUrl returns UnknownImplementationsQueryOtherItemArticle.Url, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemArticle) GetUrl() string {
+	return v.ContentFieldsArticle.Url
+}
+
+func (v *UnknownImplementationsQueryOtherItemArticle) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnknownImplementationsQueryOtherItemArticle
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnknownImplementationsQueryOtherItemArticle = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContentFieldsArticle)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnknownImplementationsQueryOtherItemArticle struct {
+	Typename string `json:"__typename"`
+
+	Id testutil.ID `json:"id"`
+
+	Url string `json:"url"`
+}
+
+func (v *UnknownImplementationsQueryOtherItemArticle) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnknownImplementationsQueryOtherItemArticle) __premarshalJSON() (*__premarshalUnknownImplementationsQueryOtherItemArticle, error) {
+	var retval __premarshalUnknownImplementationsQueryOtherItemArticle
+
+	retval.Typename = v.Typename
+	retval.Id = v.ContentFieldsArticle.Id
+	retval.Url = v.ContentFieldsArticle.Url
+	return &retval, nil
+}
+
+// UnknownImplementationsQueryOtherItemContent includes the requested fields of the GraphQL interface Content.
+//
+// UnknownImplementationsQueryOtherItemContent is implemented by the following types:
+// UnknownImplementationsQueryOtherItemArticle
+// UnknownImplementationsQueryOtherItemTopic
+// UnknownImplementationsQueryOtherItemVideo
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type UnknownImplementationsQueryOtherItemContent interface {
+	implementsGraphQLInterfaceUnknownImplementationsQueryOtherItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	ContentFields
+}
+
+func (v *UnknownImplementationsQueryOtherItemArticle) implementsGraphQLInterfaceUnknownImplementationsQueryOtherItemContent() {
+}
+func (v *UnknownImplementationsQueryOtherItemTopic) implementsGraphQLInterfaceUnknownImplementationsQueryOtherItemContent() {
+}
+func (v *UnknownImplementationsQueryOtherItemVideo) implementsGraphQLInterfaceUnknownImplementationsQueryOtherItemContent() {
+}
+
+func __unmarshalUnknownImplementationsQueryOtherItemContent(b []byte, v *UnknownImplementationsQueryOtherItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(UnknownImplementationsQueryOtherItemArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(UnknownImplementationsQueryOtherItemTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(UnknownImplementationsQueryOtherItemVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for UnknownImplementationsQueryOtherItemContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalUnknownImplementationsQueryOtherItemContent(v *UnknownImplementationsQueryOtherItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnknownImplementationsQueryOtherItemArticle:
+		typename = "Article"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnknownImplementationsQueryOtherItemArticle
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryOtherItemTopic:
+		typename = "Topic"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnknownImplementationsQueryOtherItemTopic
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryOtherItemVideo:
+		typename = "Video"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnknownImplementationsQueryOtherItemVideo
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnknownImplementationsQueryOtherItemContent: "%T"`, v)
+	}
+}
+
+// UnknownImplementationsQueryOtherItemTopic includes the requested fields of the GraphQL type Topic.
+type UnknownImplementationsQueryOtherItemTopic struct {
+	Typename           string `json:"__typename"`
+	ContentFieldsTopic `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryOtherItemTopic.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemTopic) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryOtherItemTopic.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemTopic) GetId() testutil.ID {
+	return v.ContentFieldsTopic.Id
+}
+
+// GetUrl returns UnknownImplementationsQueryOtherItemTopic.Url, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemTopic) GetUrl() string { return v.ContentFieldsTopic.Url }
+
+func (v *UnknownImplementationsQueryOtherItemTopic) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnknownImplementationsQueryOtherItemTopic
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnknownImplementationsQueryOtherItemTopic = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContentFieldsTopic)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnknownImplementationsQueryOtherItemTopic struct {
+	Typename string `json:"__typename"`
+
+	Id testutil.ID `json:"id"`
+
+	Url string `json:"url"`
+}
+
+func (v *UnknownImplementationsQueryOtherItemTopic) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnknownImplementationsQueryOtherItemTopic) __premarshalJSON() (*__premarshalUnknownImplementationsQueryOtherItemTopic, error) {
+	var retval __premarshalUnknownImplementationsQueryOtherItemTopic
+
+	retval.Typename = v.Typename
+	retval.Id = v.ContentFieldsTopic.Id
+	retval.Url = v.ContentFieldsTopic.Url
+	return &retval, nil
+}
+
+// UnknownImplementationsQueryOtherItemVideo includes the requested fields of the GraphQL type Video.
+type UnknownImplementationsQueryOtherItemVideo struct {
+	Typename           string `json:"__typename"`
+	ContentFieldsVideo `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryOtherItemVideo.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemVideo) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryOtherItemVideo.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemVideo) GetId() testutil.ID {
+	return v.ContentFieldsVideo.Id
+}
+
+// GetUrl returns UnknownImplementationsQueryOtherItemVideo.Url, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryOtherItemVideo) GetUrl() string { return v.ContentFieldsVideo.Url }
+
+func (v *UnknownImplementationsQueryOtherItemVideo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnknownImplementationsQueryOtherItemVideo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnknownImplementationsQueryOtherItemVideo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContentFieldsVideo)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnknownImplementationsQueryOtherItemVideo struct {
+	Typename string `json:"__typename"`
+
+	Id testutil.ID `json:"id"`
+
+	Url string `json:"url"`
+}
+
+func (v *UnknownImplementationsQueryOtherItemVideo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnknownImplementationsQueryOtherItemVideo) __premarshalJSON() (*__premarshalUnknownImplementationsQueryOtherItemVideo, error) {
+	var retval __premarshalUnknownImplementationsQueryOtherItemVideo
+
+	retval.Typename = v.Typename
+	retval.Id = v.ContentFieldsVideo.Id
+	retval.Url = v.ContentFieldsVideo.Url
+	return &retval, nil
+}
+
+// UnknownImplementationsQueryRandomItemArticle includes the requested fields of the GraphQL type Article.
+type UnknownImplementationsQueryRandomItemArticle struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+	Text string      `json:"text"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomItemArticle.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemArticle) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryRandomItemArticle.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemArticle) GetId() testutil.ID { return v.Id }
+
+// GetName returns UnknownImplementationsQueryRandomItemArticle.Name, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemArticle) GetName() string { return v.Name }
+
+// GetText returns UnknownImplementationsQueryRandomItemArticle.Text, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemArticle) GetText() string { return v.Text }
+
+// UnknownImplementationsQueryRandomItemContent includes the requested fields of the GraphQL interface Content.
+//
+// UnknownImplementationsQueryRandomItemContent is implemented by the following types:
+// UnknownImplementationsQueryRandomItemArticle
+// UnknownImplementationsQueryRandomItemTopic
+// UnknownImplementationsQueryRandomItemVideo
+// UnknownImplementationsQueryRandomItemContentUnknown
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type UnknownImplementationsQueryRandomItemContent interface {
+	implementsGraphQLInterfaceUnknownImplementationsQueryRandomItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// ID is the identifier of the content.
+	GetId() testutil.ID
+	// GetName returns the interface-field "name" from its implementation.
+	GetName() string
+}
+
+func (v *UnknownImplementationsQueryRandomItemArticle) implementsGraphQLInterfaceUnknownImplementationsQueryRandomItemContent() {
+}
+func (v *UnknownImplementationsQueryRandomItemTopic) implementsGraphQLInterfaceUnknownImplementationsQueryRandomItemContent() {
+}
+func (v *UnknownImplementationsQueryRandomItemVideo) implementsGraphQLInterfaceUnknownImplementationsQueryRandomItemContent() {
+}
+func (v *UnknownImplementationsQueryRandomItemContentUnknown) implementsGraphQLInterfaceUnknownImplementationsQueryRandomItemContent() {
+}
+
+func __unmarshalUnknownImplementationsQueryRandomItemContent(b []byte, v *UnknownImplementationsQueryRandomItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(UnknownImplementationsQueryRandomItemArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(UnknownImplementationsQueryRandomItemTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(UnknownImplementationsQueryRandomItemVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		unknown := new(UnknownImplementationsQueryRandomItemContentUnknown)
+		err = json.Unmarshal(b, unknown)
+		if err != nil {
+			return err
+		}
+		unknown.Raw = append(json.RawMessage(nil), b...)
+		*v = unknown
+		return nil
+	}
+}
+
+func __marshalUnknownImplementationsQueryRandomItemContent(v *UnknownImplementationsQueryRandomItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnknownImplementationsQueryRandomItemArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryRandomItemArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryRandomItemTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryRandomItemTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryRandomItemVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryRandomItemVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryRandomItemContentUnknown:
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnknownImplementationsQueryRandomItemContent: "%T"`, v)
+	}
+}
+
+// UnknownImplementationsQueryRandomItemContentUnknown is the implementation of UnknownImplementationsQueryRandomItemContent for GraphQL types which were not known when this code was generated.  It includes the requested fields shared by all types.
+type UnknownImplementationsQueryRandomItemContentUnknown struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+	// Raw is the JSON of the object, as returned by the server.
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomItemContentUnknown.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemContentUnknown) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryRandomItemContentUnknown.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemContentUnknown) GetId() testutil.ID { return v.Id }
+
+// GetName returns UnknownImplementationsQueryRandomItemContentUnknown.Name, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemContentUnknown) GetName() string { return v.Name }
+
+// GetRaw returns UnknownImplementationsQueryRandomItemContentUnknown.Raw, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemContentUnknown) GetRaw() json.RawMessage { return v.Raw }
+
+// UnknownImplementationsQueryRandomItemTopic includes the requested fields of the GraphQL type Topic.
+type UnknownImplementationsQueryRandomItemTopic struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomItemTopic.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemTopic) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryRandomItemTopic.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemTopic) GetId() testutil.ID { return v.Id }
+
+// GetName returns UnknownImplementationsQueryRandomItemTopic.Name, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemTopic) GetName() string { return v.Name }
+
+// UnknownImplementationsQueryRandomItemVideo includes the requested fields of the GraphQL type Video.
+type UnknownImplementationsQueryRandomItemVideo struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   testutil.ID `json:"id"`
+	Name string      `json:"name"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomItemVideo.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemVideo) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryRandomItemVideo.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemVideo) GetId() testutil.ID { return v.Id }
+
+// GetName returns UnknownImplementationsQueryRandomItemVideo.Name, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomItemVideo) GetName() string { return v.Name }
+
+// UnknownImplementationsQueryRandomLeafArticle includes the requested fields of the GraphQL type Article.
+type UnknownImplementationsQueryRandomLeafArticle struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomLeafArticle.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomLeafArticle) GetTypename() string { return v.Typename }
+
+// UnknownImplementationsQueryRandomLeafLeafContent includes the requested fields of the GraphQL interface LeafContent.
+//
+// UnknownImplementationsQueryRandomLeafLeafContent is implemented by the following types:
+// UnknownImplementationsQueryRandomLeafArticle
+// UnknownImplementationsQueryRandomLeafVideo
+// UnknownImplementationsQueryRandomLeafLeafContentUnknown
+// The GraphQL type's documentation follows.
+//
+// LeafContent represents content items that can't have child-nodes.
+type UnknownImplementationsQueryRandomLeafLeafContent interface {
+	implementsGraphQLInterfaceUnknownImplementationsQueryRandomLeafLeafContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *UnknownImplementationsQueryRandomLeafArticle) implementsGraphQLInterfaceUnknownImplementationsQueryRandomLeafLeafContent() {
+}
+func (v *UnknownImplementationsQueryRandomLeafVideo) implementsGraphQLInterfaceUnknownImplementationsQueryRandomLeafLeafContent() {
+}
+func (v *UnknownImplementationsQueryRandomLeafLeafContentUnknown) implementsGraphQLInterfaceUnknownImplementationsQueryRandomLeafLeafContent() {
+}
+
+func __unmarshalUnknownImplementationsQueryRandomLeafLeafContent(b []byte, v *UnknownImplementationsQueryRandomLeafLeafContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(UnknownImplementationsQueryRandomLeafArticle)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(UnknownImplementationsQueryRandomLeafVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing LeafContent.__typename")
+	default:
+		unknown := new(UnknownImplementationsQueryRandomLeafLeafContentUnknown)
+		err = json.Unmarshal(b, unknown)
+		if err != nil {
+			return err
+		}
+		unknown.Raw = append(json.RawMessage(nil), b...)
+		*v = unknown
+		return nil
+	}
+}
+
+func __marshalUnknownImplementationsQueryRandomLeafLeafContent(v *UnknownImplementationsQueryRandomLeafLeafContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnknownImplementationsQueryRandomLeafArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryRandomLeafArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryRandomLeafVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryRandomLeafVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryRandomLeafLeafContentUnknown:
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnknownImplementationsQueryRandomLeafLeafContent: "%T"`, v)
+	}
+}
+
+// UnknownImplementationsQueryRandomLeafLeafContentUnknown is the implementation of UnknownImplementationsQueryRandomLeafLeafContent for GraphQL types which were not known when this code was generated.  It includes the requested fields shared by all types.
+type UnknownImplementationsQueryRandomLeafLeafContentUnknown struct {
+	Typename string `json:"__typename"`
+	// Raw is the JSON of the object, as returned by the server.
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomLeafLeafContentUnknown.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomLeafLeafContentUnknown) GetTypename() string {
+	return v.Typename
+}
+
+// GetRaw returns UnknownImplementationsQueryRandomLeafLeafContentUnknown.Raw, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomLeafLeafContentUnknown) GetRaw() json.RawMessage {
+	return v.Raw
+}
+
+// UnknownImplementationsQueryRandomLeafVideo includes the requested fields of the GraphQL type Video.
+type UnknownImplementationsQueryRandomLeafVideo struct {
+	Typename string `json:"__typename"`
+	Duration int    `json:"duration"`
+}
+
+// GetTypename returns UnknownImplementationsQueryRandomLeafVideo.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomLeafVideo) GetTypename() string { return v.Typename }
+
+// GetDuration returns UnknownImplementationsQueryRandomLeafVideo.Duration, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryRandomLeafVideo) GetDuration() int { return v.Duration }
+
+// UnknownImplementationsQueryResponse is returned by UnknownImplementationsQuery on success.
+type UnknownImplementationsQueryResponse struct {
+	RandomItem  UnknownImplementationsQueryRandomItemContent     `json:"-"`
+	RandomLeaf  UnknownImplementationsQueryRandomLeafLeafContent `json:"-"`
+	WithPointer *UnknownImplementationsQueryWithPointerContent   `json:"-"`
+	OtherItem   UnknownImplementationsQueryOtherItemContent      `json:"-"`
+}
+
+// GetRandomItem returns UnknownImplementationsQueryResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryResponse) GetRandomItem() UnknownImplementationsQueryRandomItemContent {
+	return v.RandomItem
+}
+
+// GetRandomLeaf returns UnknownImplementationsQueryResponse.RandomLeaf, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryResponse) GetRandomLeaf() UnknownImplementationsQueryRandomLeafLeafContent {
+	return v.RandomLeaf
+}
+
+// GetWithPointer returns UnknownImplementationsQueryResponse.WithPointer, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryResponse) GetWithPointer() *UnknownImplementationsQueryWithPointerContent {
+	return v.WithPointer
+}
+
+// GetOtherItem returns UnknownImplementationsQueryResponse.OtherItem, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryResponse) GetOtherItem() UnknownImplementationsQueryOtherItemContent {
+	return v.OtherItem
+}
+
+func (v *UnknownImplementationsQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnknownImplementationsQueryResponse
+		RandomItem  json.RawMessage `json:"randomItem"`
+		RandomLeaf  json.RawMessage `json:"randomLeaf"`
+		WithPointer json.RawMessage `json:"withPointer"`
+		OtherItem   json.RawMessage `json:"otherItem"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnknownImplementationsQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RandomItem
+		src := firstPass.RandomItem
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalUnknownImplementationsQueryRandomItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UnknownImplementationsQueryResponse.RandomItem: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.RandomLeaf
+		src := firstPass.RandomLeaf
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalUnknownImplementationsQueryRandomLeafLeafContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UnknownImplementationsQueryResponse.RandomLeaf: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.WithPointer
+		src := firstPass.WithPointer
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(UnknownImplementationsQueryWithPointerContent)
+			err = __unmarshalUnknownImplementationsQueryWithPointerContent(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UnknownImplementationsQueryResponse.WithPointer: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.OtherItem
+		src := firstPass.OtherItem
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalUnknownImplementationsQueryOtherItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UnknownImplementationsQueryResponse.OtherItem: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalUnknownImplementationsQueryResponse struct {
+	RandomItem json.RawMessage `json:"randomItem"`
+
+	RandomLeaf json.RawMessage `json:"randomLeaf"`
+
+	WithPointer json.RawMessage `json:"withPointer"`
+
+	OtherItem json.RawMessage `json:"otherItem"`
+}
+
+func (v *UnknownImplementationsQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnknownImplementationsQueryResponse) __premarshalJSON() (*__premarshalUnknownImplementationsQueryResponse, error) {
+	var retval __premarshalUnknownImplementationsQueryResponse
+
+	{
+
+		dst := &retval.RandomItem
+		src := v.RandomItem
+		var err error
+		*dst, err = __marshalUnknownImplementationsQueryRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UnknownImplementationsQueryResponse.RandomItem: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.RandomLeaf
+		src := v.RandomLeaf
+		var err error
+		*dst, err = __marshalUnknownImplementationsQueryRandomLeafLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UnknownImplementationsQueryResponse.RandomLeaf: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.WithPointer
+		src := v.WithPointer
+		if src != nil {
+			var err error
+			*dst, err = __marshalUnknownImplementationsQueryWithPointerContent(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal UnknownImplementationsQueryResponse.WithPointer: %w", err)
+			}
+		}
+	}
+	{
+
+		dst := &retval.OtherItem
+		src := v.OtherItem
+		var err error
+		*dst, err = __marshalUnknownImplementationsQueryOtherItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UnknownImplementationsQueryResponse.OtherItem: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// UnknownImplementationsQueryWithPointerArticle includes the requested fields of the GraphQL type Article.
+type UnknownImplementationsQueryWithPointerArticle struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetTypename returns UnknownImplementationsQueryWithPointerArticle.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerArticle) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryWithPointerArticle.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerArticle) GetId() testutil.ID { return v.Id }
+
+// UnknownImplementationsQueryWithPointerContent includes the requested fields of the GraphQL interface Content.
+//
+// UnknownImplementationsQueryWithPointerContent is implemented by the following types:
+// UnknownImplementationsQueryWithPointerArticle
+// UnknownImplementationsQueryWithPointerTopic
+// UnknownImplementationsQueryWithPointerVideo
+// UnknownImplementationsQueryWithPointerContentUnknown
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type UnknownImplementationsQueryWithPointerContent interface {
+	implementsGraphQLInterfaceUnknownImplementationsQueryWithPointerContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// ID is the identifier of the content.
+	GetId() testutil.ID
+}
+
+func (v *UnknownImplementationsQueryWithPointerArticle) implementsGraphQLInterfaceUnknownImplementationsQueryWithPointerContent() {
+}
+func (v *UnknownImplementationsQueryWithPointerTopic) implementsGraphQLInterfaceUnknownImplementationsQueryWithPointerContent() {
+}
+func (v *UnknownImplementationsQueryWithPointerVideo) implementsGraphQLInterfaceUnknownImplementationsQueryWithPointerContent() {
+}
+func (v *UnknownImplementationsQueryWithPointerContentUnknown) implementsGraphQLInterfaceUnknownImplementationsQueryWithPointerContent() {
+}
+
+func __unmarshalUnknownImplementationsQueryWithPointerContent(b []byte, v *UnknownImplementationsQueryWithPointerContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(UnknownImplementationsQueryWithPointerArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(UnknownImplementationsQueryWithPointerTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(UnknownImplementationsQueryWithPointerVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		unknown := new(UnknownImplementationsQueryWithPointerContentUnknown)
+		err = json.Unmarshal(b, unknown)
+		if err != nil {
+			return err
+		}
+		unknown.Raw = append(json.RawMessage(nil), b...)
+		*v = unknown
+		return nil
+	}
+}
+
+func __marshalUnknownImplementationsQueryWithPointerContent(v *UnknownImplementationsQueryWithPointerContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnknownImplementationsQueryWithPointerArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryWithPointerArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryWithPointerTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryWithPointerTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryWithPointerVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*UnknownImplementationsQueryWithPointerVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case *UnknownImplementationsQueryWithPointerContentUnknown:
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnknownImplementationsQueryWithPointerContent: "%T"`, v)
+	}
+}
+
+// UnknownImplementationsQueryWithPointerContentUnknown is the implementation of UnknownImplementationsQueryWithPointerContent for GraphQL types which were not known when this code was generated.  It includes the requested fields shared by all types.
+type UnknownImplementationsQueryWithPointerContentUnknown struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+	// Raw is the JSON of the object, as returned by the server.
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTypename returns UnknownImplementationsQueryWithPointerContentUnknown.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerContentUnknown) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns UnknownImplementationsQueryWithPointerContentUnknown.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerContentUnknown) GetId() testutil.ID { return v.Id }
+
+// GetRaw returns UnknownImplementationsQueryWithPointerContentUnknown.Raw, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerContentUnknown) GetRaw() json.RawMessage { return v.Raw }
+
+// UnknownImplementationsQueryWithPointerTopic includes the requested fields of the GraphQL type Topic.
+type UnknownImplementationsQueryWithPointerTopic struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetTypename returns UnknownImplementationsQueryWithPointerTopic.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerTopic) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryWithPointerTopic.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerTopic) GetId() testutil.ID { return v.Id }
+
+// UnknownImplementationsQueryWithPointerVideo includes the requested fields of the GraphQL type Video.
+type UnknownImplementationsQueryWithPointerVideo struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id testutil.ID `json:"id"`
+}
+
+// GetTypename returns UnknownImplementationsQueryWithPointerVideo.Typename, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerVideo) GetTypename() string { return v.Typename }
+
+// GetId returns UnknownImplementationsQueryWithPointerVideo.Id, and is useful for accessing the field via an interface.
+func (v *UnknownImplementationsQueryWithPointerVideo) GetId() testutil.ID { return v.Id }
+
+// The query executed by UnknownImplementationsQuery.
+const UnknownImplementationsQuery_Operation = `
+query UnknownImplementationsQuery {
+	randomItem {
+		__typename
+		id
+		name
+		... on Article {
+			text
+		}
+	}
+	randomLeaf {
+		__typename
+		... on Video {
+			duration
+		}
+	}
+	withPointer: randomItem {
+		__typename
+		id
+	}
+	otherItem: randomItem {
+		__typename
+		... ContentFields
+	}
+}
+fragment ContentFields on Content {
+	id
+	url
+}
+`
+
+// The SHA-256 hash of UnknownImplementationsQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const UnknownImplementationsQuery_OperationHash = "7d4d5ca4a4adf8bbb6c8bac26892121aef528bf889bd8ab6440d1ee3033301df"
+
+func UnknownImplementationsQuery(
+	client_ graphql.Client,
+) (data_ *UnknownImplementationsQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UnknownImplementationsQuery",
+		Query:  UnknownImplementationsQuery_Operation,
+		Hash:   UnknownImplementationsQuery_OperationHash,
+	}
+
+	data_ = &UnknownImplementationsQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		nil,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
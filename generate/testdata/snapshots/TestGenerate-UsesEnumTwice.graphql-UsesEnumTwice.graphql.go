@@ -25,6 +25,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UsesEnumTwiceQueryMeUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
@@ -79,12 +102,17 @@ query UsesEnumTwiceQuery {
 }
 `
 
+// The SHA-256 hash of UsesEnumTwiceQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const UsesEnumTwiceQuery_OperationHash = "6023f30cb636680d47ab152895913ca024d5b09d136d198f99559efe4d7257d1"
+
 func UsesEnumTwiceQuery(
 	client_ graphql.Client,
 ) (data_ *UsesEnumTwiceQueryResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "UsesEnumTwiceQuery",
 		Query:  UsesEnumTwiceQuery_Operation,
+		Hash:   UsesEnumTwiceQuery_OperationHash,
 	}
 
 	data_ = &UsesEnumTwiceQueryResponse{}
@@ -30,6 +30,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -191,6 +214,10 @@ query unexported ($query: UserQueryInput) {
 }
 `
 
+// The SHA-256 hash of unexported_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const unexported_OperationHash = "d1f8824f45f036da72f40408147242da933fffecc3c3d0ef3f7c5a5aabfe04aa"
+
 func unexported(
 	client_ graphql.Client,
 	query UserQueryInput,
@@ -198,6 +225,7 @@ func unexported(
 	req_ := &graphql.Request{
 		OpName: "unexported",
 		Query:  unexported_Operation,
+		Hash:   unexported_OperationHash,
 		Variables: &__unexportedInput{
 			Query: query,
 		},
@@ -0,0 +1,85 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package posts
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// QueryWithStructsResponse is returned by QueryWithStructs on success.
+type QueryWithStructsResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User QueryWithStructsUser `json:"user"`
+}
+
+// GetUser returns QueryWithStructsResponse.User, and is useful for accessing the field via an interface.
+func (v *QueryWithStructsResponse) GetUser() QueryWithStructsUser { return v.User }
+
+// QueryWithStructsUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type QueryWithStructsUser struct {
+	AuthMethods []QueryWithStructsUserAuthMethodsAuthMethod `json:"authMethods"`
+}
+
+// GetAuthMethods returns QueryWithStructsUser.AuthMethods, and is useful for accessing the field via an interface.
+func (v *QueryWithStructsUser) GetAuthMethods() []QueryWithStructsUserAuthMethodsAuthMethod {
+	return v.AuthMethods
+}
+
+// QueryWithStructsUserAuthMethodsAuthMethod includes the requested fields of the GraphQL type AuthMethod.
+type QueryWithStructsUserAuthMethodsAuthMethod struct {
+	Provider string `json:"provider"`
+	Email    string `json:"email"`
+}
+
+// GetProvider returns QueryWithStructsUserAuthMethodsAuthMethod.Provider, and is useful for accessing the field via an interface.
+func (v *QueryWithStructsUserAuthMethodsAuthMethod) GetProvider() string { return v.Provider }
+
+// GetEmail returns QueryWithStructsUserAuthMethodsAuthMethod.Email, and is useful for accessing the field via an interface.
+func (v *QueryWithStructsUserAuthMethodsAuthMethod) GetEmail() string { return v.Email }
+
+// The query executed by QueryWithStructs.
+const QueryWithStructs_Operation = `
+query QueryWithStructs {
+	user {
+		authMethods {
+			provider
+			email
+		}
+	}
+}
+`
+
+// The SHA-256 hash of QueryWithStructs_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithStructs_OperationHash = "a9f9b2a3048c540188752153837017ebd5f9cfd71ceafa610990bc5d78a5ddc2"
+
+func QueryWithStructs(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *QueryWithStructsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "QueryWithStructs",
+		Query:  QueryWithStructs_Operation,
+		Hash:   QueryWithStructs_OperationHash,
+	}
+
+	data_ = &QueryWithStructsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
@@ -0,0 +1,413 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// ComplexNamedFragmentsResponse is returned by ComplexNamedFragments on success.
+type ComplexNamedFragmentsResponse struct {
+	QueryFragment `json:"-"`
+}
+
+// GetRandomItem returns ComplexNamedFragmentsResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetRandomItem() InnerQueryFragmentRandomItemContent {
+	return v.QueryFragment.InnerQueryFragment.RandomItem
+}
+
+// GetRandomLeaf returns ComplexNamedFragmentsResponse.RandomLeaf, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetRandomLeaf() InnerQueryFragmentRandomLeafLeafContent {
+	return v.QueryFragment.InnerQueryFragment.RandomLeaf
+}
+
+// GetOtherLeaf returns ComplexNamedFragmentsResponse.OtherLeaf, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetOtherLeaf() InnerQueryFragmentOtherLeafLeafContent {
+	return v.QueryFragment.InnerQueryFragment.OtherLeaf
+}
+
+func (v *ComplexNamedFragmentsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ComplexNamedFragmentsResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ComplexNamedFragmentsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.QueryFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalComplexNamedFragmentsResponse struct {
+	RandomItem json.RawMessage `json:"randomItem"`
+
+	RandomLeaf json.RawMessage `json:"randomLeaf"`
+
+	OtherLeaf json.RawMessage `json:"otherLeaf"`
+}
+
+func (v *ComplexNamedFragmentsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ComplexNamedFragmentsResponse) __premarshalJSON() (*__premarshalComplexNamedFragmentsResponse, error) {
+	var retval __premarshalComplexNamedFragmentsResponse
+
+	{
+
+		dst := &retval.RandomItem
+		src := v.QueryFragment.InnerQueryFragment.RandomItem
+		var err error
+		*dst, err = __marshalInnerQueryFragmentRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.RandomItem: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.RandomLeaf
+		src := v.QueryFragment.InnerQueryFragment.RandomLeaf
+		var err error
+		*dst, err = __marshalInnerQueryFragmentRandomLeafLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.RandomLeaf: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.OtherLeaf
+		src := v.QueryFragment.InnerQueryFragment.OtherLeaf
+		var err error
+		*dst, err = __marshalInnerQueryFragmentOtherLeafLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.OtherLeaf: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ComplexNamedFragmentsWithInlineUnionResponse is returned by ComplexNamedFragmentsWithInlineUnion on success.
+type ComplexNamedFragmentsWithInlineUnionResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User ComplexNamedFragmentsWithInlineUnionUser      `json:"user"`
+	Root ComplexNamedFragmentsWithInlineUnionRootTopic `json:"root"`
+}
+
+// GetUser returns ComplexNamedFragmentsWithInlineUnionResponse.User, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsWithInlineUnionResponse) GetUser() ComplexNamedFragmentsWithInlineUnionUser {
+	return v.User
+}
+
+// GetRoot returns ComplexNamedFragmentsWithInlineUnionResponse.Root, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsWithInlineUnionResponse) GetRoot() ComplexNamedFragmentsWithInlineUnionRootTopic {
+	return v.Root
+}
+
+// ComplexNamedFragmentsWithInlineUnionRootTopic includes the requested fields of the GraphQL type Topic.
+type ComplexNamedFragmentsWithInlineUnionRootTopic struct {
+	TopicNewestContent `json:"-"`
+}
+
+// GetNewestContent returns ComplexNamedFragmentsWithInlineUnionRootTopic.NewestContent, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsWithInlineUnionRootTopic) GetNewestContent() TopicNewestContentNewestContentLeafContent {
+	return v.TopicNewestContent.NewestContent
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionRootTopic) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ComplexNamedFragmentsWithInlineUnionRootTopic
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ComplexNamedFragmentsWithInlineUnionRootTopic = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TopicNewestContent)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalComplexNamedFragmentsWithInlineUnionRootTopic struct {
+	NewestContent json.RawMessage `json:"newestContent"`
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionRootTopic) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionRootTopic) __premarshalJSON() (*__premarshalComplexNamedFragmentsWithInlineUnionRootTopic, error) {
+	var retval __premarshalComplexNamedFragmentsWithInlineUnionRootTopic
+
+	{
+
+		dst := &retval.NewestContent
+		src := v.TopicNewestContent.NewestContent
+		var err error
+		*dst, err = __marshalTopicNewestContentNewestContentLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsWithInlineUnionRootTopic.TopicNewestContent.NewestContent: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ComplexNamedFragmentsWithInlineUnionUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type ComplexNamedFragmentsWithInlineUnionUser struct {
+	UserLastContent `json:"-"`
+}
+
+// GetLastContent returns ComplexNamedFragmentsWithInlineUnionUser.LastContent, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsWithInlineUnionUser) GetLastContent() UserLastContentLastContentLeafContent {
+	return v.UserLastContent.LastContent
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ComplexNamedFragmentsWithInlineUnionUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ComplexNamedFragmentsWithInlineUnionUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UserLastContent)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalComplexNamedFragmentsWithInlineUnionUser struct {
+	LastContent json.RawMessage `json:"lastContent"`
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ComplexNamedFragmentsWithInlineUnionUser) __premarshalJSON() (*__premarshalComplexNamedFragmentsWithInlineUnionUser, error) {
+	var retval __premarshalComplexNamedFragmentsWithInlineUnionUser
+
+	{
+
+		dst := &retval.LastContent
+		src := v.UserLastContent.LastContent
+		var err error
+		*dst, err = __marshalUserLastContentLastContentLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsWithInlineUnionUser.UserLastContent.LastContent: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// The query executed by ComplexNamedFragments.
+const ComplexNamedFragments_Operation = `
+query ComplexNamedFragments {
+	... on Query {
+		... QueryFragment
+	}
+}
+fragment QueryFragment on Query {
+	... InnerQueryFragment
+}
+fragment InnerQueryFragment on Query {
+	randomItem {
+		__typename
+		id
+		name
+		... VideoFields
+		... ContentFields
+	}
+	randomLeaf {
+		__typename
+		... VideoFields
+		... MoreVideoFields
+		... ContentFields
+	}
+	otherLeaf: randomLeaf {
+		__typename
+		... on Video {
+			... MoreVideoFields
+			... ContentFields
+		}
+		... ContentFields
+	}
+}
+fragment VideoFields on Video {
+	id
+	name
+	url
+	duration
+	thumbnail {
+		id
+	}
+	... ContentFields
+}
+fragment ContentFields on Content {
+	name
+	url
+}
+fragment MoreVideoFields on Video {
+	id
+	parent {
+		name
+		url
+		... ContentFields
+		children {
+			__typename
+			... VideoFields
+		}
+	}
+}
+`
+
+// The SHA-256 hash of ComplexNamedFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragments_OperationHash = "958c554723f092e64f904fb70bc393c2d71aa9e895726b03efcf921b4ecdfbe2"
+
+func ComplexNamedFragments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *ComplexNamedFragmentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ComplexNamedFragments",
+		Query:  ComplexNamedFragments_Operation,
+		Hash:   ComplexNamedFragments_OperationHash,
+	}
+
+	data_ = &ComplexNamedFragmentsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ComplexNamedFragmentsWithInlineUnion.
+const ComplexNamedFragmentsWithInlineUnion_Operation = `
+query ComplexNamedFragmentsWithInlineUnion {
+	user {
+		... UserLastContent
+	}
+	root {
+		... TopicNewestContent
+	}
+}
+fragment UserLastContent on User {
+	lastContent {
+		__typename
+		... SimpleLeafContent
+	}
+}
+fragment TopicNewestContent on Topic {
+	newestContent {
+		__typename
+		... SimpleLeafContent
+	}
+}
+fragment SimpleLeafContent on LeafContent {
+	... on Article {
+		id
+	}
+	... on Video {
+		id
+	}
+}
+`
+
+// The SHA-256 hash of ComplexNamedFragmentsWithInlineUnion_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragmentsWithInlineUnion_OperationHash = "97778b892eb352d0e3f48b1248fb7e5bebaaec3ddd2e3de3fd12d7d910269464"
+
+func ComplexNamedFragmentsWithInlineUnion(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *ComplexNamedFragmentsWithInlineUnionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ComplexNamedFragmentsWithInlineUnion",
+		Query:  ComplexNamedFragmentsWithInlineUnion_Operation,
+		Hash:   ComplexNamedFragmentsWithInlineUnion_OperationHash,
+	}
+
+	data_ = &ComplexNamedFragmentsWithInlineUnionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
@@ -0,0 +1,90 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// QueryWithEnumsOtherUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type QueryWithEnumsOtherUser struct {
+	Roles []Role `json:"roles"`
+}
+
+// GetRoles returns QueryWithEnumsOtherUser.Roles, and is useful for accessing the field via an interface.
+func (v *QueryWithEnumsOtherUser) GetRoles() []Role { return v.Roles }
+
+// QueryWithEnumsResponse is returned by QueryWithEnums on success.
+type QueryWithEnumsResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User QueryWithEnumsUser `json:"user"`
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	OtherUser QueryWithEnumsOtherUser `json:"otherUser"`
+}
+
+// GetUser returns QueryWithEnumsResponse.User, and is useful for accessing the field via an interface.
+func (v *QueryWithEnumsResponse) GetUser() QueryWithEnumsUser { return v.User }
+
+// GetOtherUser returns QueryWithEnumsResponse.OtherUser, and is useful for accessing the field via an interface.
+func (v *QueryWithEnumsResponse) GetOtherUser() QueryWithEnumsOtherUser { return v.OtherUser }
+
+// QueryWithEnumsUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type QueryWithEnumsUser struct {
+	Roles []Role `json:"roles"`
+}
+
+// GetRoles returns QueryWithEnumsUser.Roles, and is useful for accessing the field via an interface.
+func (v *QueryWithEnumsUser) GetRoles() []Role { return v.Roles }
+
+// The query executed by QueryWithEnums.
+const QueryWithEnums_Operation = `
+query QueryWithEnums {
+	user {
+		roles
+	}
+	otherUser: user {
+		roles
+	}
+}
+`
+
+// The SHA-256 hash of QueryWithEnums_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithEnums_OperationHash = "bc2cd19197ad2eeebd4b5e298f054a1d2905bf350102092b8ab331edd376af54"
+
+func QueryWithEnums(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *QueryWithEnumsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "QueryWithEnums",
+		Query:  QueryWithEnums_Operation,
+		Hash:   QueryWithEnums_OperationHash,
+	}
+
+	data_ = &QueryWithEnumsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
@@ -0,0 +1,202 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// ComplexNamedFragmentsResponse is returned by ComplexNamedFragments on success.
+type ComplexNamedFragmentsResponse struct {
+	QueryFragment `json:"-"`
+}
+
+// GetRandomItem returns ComplexNamedFragmentsResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetRandomItem() InnerQueryFragmentRandomItemContent {
+	return v.QueryFragment.InnerQueryFragment.RandomItem
+}
+
+// GetRandomLeaf returns ComplexNamedFragmentsResponse.RandomLeaf, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetRandomLeaf() InnerQueryFragmentRandomLeafLeafContent {
+	return v.QueryFragment.InnerQueryFragment.RandomLeaf
+}
+
+// GetOtherLeaf returns ComplexNamedFragmentsResponse.OtherLeaf, and is useful for accessing the field via an interface.
+func (v *ComplexNamedFragmentsResponse) GetOtherLeaf() InnerQueryFragmentOtherLeafLeafContent {
+	return v.QueryFragment.InnerQueryFragment.OtherLeaf
+}
+
+func (v *ComplexNamedFragmentsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ComplexNamedFragmentsResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ComplexNamedFragmentsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.QueryFragment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalComplexNamedFragmentsResponse struct {
+	RandomItem json.RawMessage `json:"randomItem"`
+
+	RandomLeaf json.RawMessage `json:"randomLeaf"`
+
+	OtherLeaf json.RawMessage `json:"otherLeaf"`
+}
+
+func (v *ComplexNamedFragmentsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ComplexNamedFragmentsResponse) __premarshalJSON() (*__premarshalComplexNamedFragmentsResponse, error) {
+	var retval __premarshalComplexNamedFragmentsResponse
+
+	{
+
+		dst := &retval.RandomItem
+		src := v.QueryFragment.InnerQueryFragment.RandomItem
+		var err error
+		*dst, err = __marshalInnerQueryFragmentRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.RandomItem: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.RandomLeaf
+		src := v.QueryFragment.InnerQueryFragment.RandomLeaf
+		var err error
+		*dst, err = __marshalInnerQueryFragmentRandomLeafLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.RandomLeaf: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.OtherLeaf
+		src := v.QueryFragment.InnerQueryFragment.OtherLeaf
+		var err error
+		*dst, err = __marshalInnerQueryFragmentOtherLeafLeafContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ComplexNamedFragmentsResponse.QueryFragment.InnerQueryFragment.OtherLeaf: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// The query executed by ComplexNamedFragments.
+const ComplexNamedFragments_Operation = `
+query ComplexNamedFragments {
+	... on Query {
+		... QueryFragment
+	}
+}
+fragment QueryFragment on Query {
+	... InnerQueryFragment
+}
+fragment InnerQueryFragment on Query {
+	randomItem {
+		__typename
+		id
+		name
+		... VideoFields
+		... ContentFields
+	}
+	randomLeaf {
+		__typename
+		... VideoFields
+		... MoreVideoFields
+		... ContentFields
+	}
+	otherLeaf: randomLeaf {
+		__typename
+		... on Video {
+			... MoreVideoFields
+			... ContentFields
+		}
+		... ContentFields
+	}
+}
+fragment VideoFields on Video {
+	id
+	name
+	url
+	duration
+	thumbnail {
+		id
+	}
+	... ContentFields
+}
+fragment ContentFields on Content {
+	name
+	url
+}
+fragment MoreVideoFields on Video {
+	id
+	parent {
+		name
+		url
+		... ContentFields
+		children {
+			__typename
+			... VideoFields
+		}
+	}
+}
+`
+
+// The SHA-256 hash of ComplexNamedFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ComplexNamedFragments_OperationHash = "958c554723f092e64f904fb70bc393c2d71aa9e895726b03efcf921b4ecdfbe2"
+
+func ComplexNamedFragments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *ComplexNamedFragmentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ComplexNamedFragments",
+		Query:  ComplexNamedFragments_Operation,
+		Hash:   ComplexNamedFragments_OperationHash,
+	}
+
+	data_ = &ComplexNamedFragmentsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
@@ -0,0 +1,71 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// SimpleQueryResponse is returned by SimpleQuery on success.
+type SimpleQueryResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User SimpleQueryUser `json:"user"`
+}
+
+// GetUser returns SimpleQueryResponse.User, and is useful for accessing the field via an interface.
+func (v *SimpleQueryResponse) GetUser() SimpleQueryUser { return v.User }
+
+// SimpleQueryUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type SimpleQueryUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id string `json:"id"`
+}
+
+// GetId returns SimpleQueryUser.Id, and is useful for accessing the field via an interface.
+func (v *SimpleQueryUser) GetId() string { return v.Id }
+
+// The query executed by SimpleQuery.
+const SimpleQuery_Operation = `
+query SimpleQuery {
+	user {
+		id
+	}
+}
+`
+
+// The SHA-256 hash of SimpleQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleQuery_OperationHash = "a37e1b1047bf42cf2c9464e0ee6b63c2d382709b63003df64e2d410cb6d043a2"
+
+func SimpleQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *SimpleQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SimpleQuery",
+		Query:  SimpleQuery_Operation,
+		Hash:   SimpleQuery_OperationHash,
+	}
+
+	data_ = &SimpleQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
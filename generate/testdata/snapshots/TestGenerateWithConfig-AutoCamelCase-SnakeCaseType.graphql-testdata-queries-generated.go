@@ -38,6 +38,10 @@ query SnakeCaseType {
 }
 `
 
+// The SHA-256 hash of SnakeCaseType_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SnakeCaseType_OperationHash = "ff1029b1427ee8aee4747d2401f8b3b57118120b55414b10651a97a418da851e"
+
 func SnakeCaseType(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -45,6 +49,7 @@ func SnakeCaseType(
 	req_ := &graphql.Request{
 		OpName: "SnakeCaseType",
 		Query:  SnakeCaseType_Operation,
+		Hash:   SnakeCaseType_OperationHash,
 	}
 
 	data_ = &SnakeCaseTypeResponse{}
@@ -47,12 +47,17 @@ query SimpleQuery {
 }
 `
 
+// The SHA-256 hash of SimpleQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleQuery_OperationHash = "a37e1b1047bf42cf2c9464e0ee6b63c2d382709b63003df64e2d410cb6d043a2"
+
 func SimpleQuery(
 	ctx_ testutil.MyContext,
 ) (data_ *SimpleQueryResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "SimpleQuery",
 		Query:  SimpleQuery_Operation,
+		Hash:   SimpleQuery_OperationHash,
 	}
 	var client_ graphql.Client
 
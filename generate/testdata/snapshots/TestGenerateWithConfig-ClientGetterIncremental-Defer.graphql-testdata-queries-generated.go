@@ -0,0 +1,379 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// DeferRandomItemArticle includes the requested fields of the GraphQL type Article.
+type DeferRandomItemArticle struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetTypename returns DeferRandomItemArticle.Typename, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemArticle) GetTypename() string { return v.Typename }
+
+// GetId returns DeferRandomItemArticle.Id, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemArticle) GetId() string { return v.Id }
+
+// GetName returns DeferRandomItemArticle.Name, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemArticle) GetName() string { return v.Name }
+
+// DeferRandomItemContent includes the requested fields of the GraphQL interface Content.
+//
+// DeferRandomItemContent is implemented by the following types:
+// DeferRandomItemArticle
+// DeferRandomItemTopic
+// DeferRandomItemVideo
+// The GraphQL type's documentation follows.
+//
+// Content is implemented by various types like Article, Video, and Topic.
+type DeferRandomItemContent interface {
+	implementsGraphQLInterfaceDeferRandomItemContent()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// ID is the identifier of the content.
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	GetName() string
+}
+
+func (v *DeferRandomItemArticle) implementsGraphQLInterfaceDeferRandomItemContent() {}
+func (v *DeferRandomItemTopic) implementsGraphQLInterfaceDeferRandomItemContent()   {}
+func (v *DeferRandomItemVideo) implementsGraphQLInterfaceDeferRandomItemContent()   {}
+
+func __unmarshalDeferRandomItemContent(b []byte, v *DeferRandomItemContent) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Article":
+		*v = new(DeferRandomItemArticle)
+		return json.Unmarshal(b, *v)
+	case "Topic":
+		*v = new(DeferRandomItemTopic)
+		return json.Unmarshal(b, *v)
+	case "Video":
+		*v = new(DeferRandomItemVideo)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Content.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for DeferRandomItemContent: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalDeferRandomItemContent(v *DeferRandomItemContent) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *DeferRandomItemArticle:
+		typename = "Article"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*DeferRandomItemArticle
+		}{typename, v}
+		return json.Marshal(result)
+	case *DeferRandomItemTopic:
+		typename = "Topic"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*DeferRandomItemTopic
+		}{typename, v}
+		return json.Marshal(result)
+	case *DeferRandomItemVideo:
+		typename = "Video"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*DeferRandomItemVideo
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for DeferRandomItemContent: "%T"`, v)
+	}
+}
+
+// DeferRandomItemTopic includes the requested fields of the GraphQL type Topic.
+type DeferRandomItemTopic struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetTypename returns DeferRandomItemTopic.Typename, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemTopic) GetTypename() string { return v.Typename }
+
+// GetId returns DeferRandomItemTopic.Id, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemTopic) GetId() string { return v.Id }
+
+// GetName returns DeferRandomItemTopic.Name, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemTopic) GetName() string { return v.Name }
+
+// DeferRandomItemVideo includes the requested fields of the GraphQL type Video.
+type DeferRandomItemVideo struct {
+	Typename string `json:"__typename"`
+	// ID is the identifier of the content.
+	Id       string `json:"id"`
+	Duration *int   `json:"duration,omitempty"`
+	Name     string `json:"name"`
+}
+
+// GetTypename returns DeferRandomItemVideo.Typename, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemVideo) GetTypename() string { return v.Typename }
+
+// GetId returns DeferRandomItemVideo.Id, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemVideo) GetId() string { return v.Id }
+
+// GetDuration returns DeferRandomItemVideo.Duration, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemVideo) GetDuration() *int { return v.Duration }
+
+// GetName returns DeferRandomItemVideo.Name, and is useful for accessing the field via an interface.
+func (v *DeferRandomItemVideo) GetName() string { return v.Name }
+
+// DeferResponse is returned by Defer on success.
+type DeferResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User       DeferUser              `json:"user"`
+	RandomItem DeferRandomItemContent `json:"-"`
+}
+
+// GetUser returns DeferResponse.User, and is useful for accessing the field via an interface.
+func (v *DeferResponse) GetUser() DeferUser { return v.User }
+
+// GetRandomItem returns DeferResponse.RandomItem, and is useful for accessing the field via an interface.
+func (v *DeferResponse) GetRandomItem() DeferRandomItemContent { return v.RandomItem }
+
+func (v *DeferResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*DeferResponse
+		RandomItem json.RawMessage `json:"randomItem"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.DeferResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RandomItem
+		src := firstPass.RandomItem
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalDeferRandomItemContent(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal DeferResponse.RandomItem: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalDeferResponse struct {
+	User DeferUser `json:"user"`
+
+	RandomItem json.RawMessage `json:"randomItem"`
+}
+
+func (v *DeferResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *DeferResponse) __premarshalJSON() (*__premarshalDeferResponse, error) {
+	var retval __premarshalDeferResponse
+
+	retval.User = v.User
+	{
+
+		dst := &retval.RandomItem
+		src := v.RandomItem
+		var err error
+		*dst, err = __marshalDeferRandomItemContent(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal DeferResponse.RandomItem: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// DeferUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type DeferUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id       string                 `json:"id"`
+	Name     *string                `json:"name,omitempty"`
+	Emails   []string               `json:"emails,omitempty"`
+	Greeting *DeferUserGreetingClip `json:"greeting,omitempty"`
+}
+
+// GetId returns DeferUser.Id, and is useful for accessing the field via an interface.
+func (v *DeferUser) GetId() string { return v.Id }
+
+// GetName returns DeferUser.Name, and is useful for accessing the field via an interface.
+func (v *DeferUser) GetName() *string { return v.Name }
+
+// GetEmails returns DeferUser.Emails, and is useful for accessing the field via an interface.
+func (v *DeferUser) GetEmails() []string { return v.Emails }
+
+// GetGreeting returns DeferUser.Greeting, and is useful for accessing the field via an interface.
+func (v *DeferUser) GetGreeting() *DeferUserGreetingClip { return v.Greeting }
+
+// DeferUserGreetingClip includes the requested fields of the GraphQL type Clip.
+// The GraphQL type's documentation follows.
+//
+// An audio clip, such as of a user saying hello.
+type DeferUserGreetingClip struct {
+	Id       string `json:"id"`
+	Duration int    `json:"duration"`
+}
+
+// GetId returns DeferUserGreetingClip.Id, and is useful for accessing the field via an interface.
+func (v *DeferUserGreetingClip) GetId() string { return v.Id }
+
+// GetDuration returns DeferUserGreetingClip.Duration, and is useful for accessing the field via an interface.
+func (v *DeferUserGreetingClip) GetDuration() int { return v.Duration }
+
+// The query executed by Defer.
+const Defer_Operation = `
+query Defer {
+	user {
+		id
+		... @defer(label: "details") {
+			name
+			emails @stream(initialCount: 1)
+			greeting {
+				id
+				duration
+			}
+		}
+	}
+	randomItem {
+		__typename
+		id
+		... on Video @defer {
+			duration
+		}
+		... @defer(if: false) {
+			name
+		}
+	}
+}
+`
+
+// The SHA-256 hash of Defer_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const Defer_OperationHash = "62d54eca9b7f1891b7018dd3bff575565ee6046471349fb1eeddc595a6319fe5"
+
+func Defer(
+	ctx_ context.Context,
+) (data_ *DeferResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName:      "Defer",
+		Query:       Defer_Operation,
+		Hash:        Defer_OperationHash,
+		Incremental: true,
+	}
+	var client_ graphql.Client
+
+	client_, err_ = testutil.GetClientFromContext(ctx_)
+	if err_ != nil {
+		return nil, err_
+	}
+
+	data_ = &DeferResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// DeferIncremental is like Defer, but delivers the response as it
+// arrives, for servers which support @defer and @stream: onPayload_ is called
+// with the response so far once the initial payload arrives, and again each
+// time a subsequent payload is merged into it.  hasNext_ is false for the
+// last call.
+func DeferIncremental(
+	ctx_ context.Context,
+	onPayload_ func(data_ *DeferResponse, hasNext_ bool) error,
+) (err_ error) {
+	req_ := &graphql.Request{
+		OpName:      "Defer",
+		Query:       Defer_Operation,
+		Hash:        Defer_OperationHash,
+		Incremental: true,
+	}
+	getClient_, err_ := testutil.GetClientFromContext(ctx_)
+	if err_ != nil {
+		return err_
+	}
+	client_, ok_ := getClient_.(graphql.IncrementalClient)
+	if !ok_ {
+		return fmt.Errorf("client %T does not support incremental delivery", getClient_)
+	}
+
+	data_ := &DeferResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	return client_.MakeIncrementalRequest(
+		ctx_,
+		req_,
+		resp_,
+		func(hasNext_ bool) error { return onPayload_(data_, hasNext_) },
+	)
+}
+
@@ -69,6 +69,29 @@ var AllRole = []Role{
 	Role_TEACHER,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case Role_STUDENT, Role_TEACHER:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // The query executed by QueryWithEnums.
 const QueryWithEnums_Operation = `
 query QueryWithEnums {
@@ -81,6 +104,10 @@ query QueryWithEnums {
 }
 `
 
+// The SHA-256 hash of QueryWithEnums_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithEnums_OperationHash = "bc2cd19197ad2eeebd4b5e298f054a1d2905bf350102092b8ab331edd376af54"
+
 func QueryWithEnums(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -88,6 +115,7 @@ func QueryWithEnums(
 	req_ := &graphql.Request{
 		OpName: "QueryWithEnums",
 		Query:  QueryWithEnums_Operation,
+		Hash:   QueryWithEnums_OperationHash,
 	}
 
 	data_ = &QueryWithEnumsResponse{}
@@ -365,6 +365,10 @@ fragment VideoFields on Video {
 }
 `
 
+// The SHA-256 hash of FlattenConfig_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const FlattenConfig_OperationHash = "f3c978adca9d25b05ca012bcc4d288cf5f029fae0ffca0fc60b992d33b584fd0"
+
 // This query has no @genqlient(flatten: ...) directives; flattening is
 // expected to be applied automatically via the global `flatten: true`
 // config option.  The fragment-spread on `randomVideo` (which returns a
@@ -377,6 +381,7 @@ func FlattenConfig(
 	req_ := &graphql.Request{
 		OpName: "FlattenConfig",
 		Query:  FlattenConfig_Operation,
+		Hash:   FlattenConfig_OperationHash,
 	}
 
 	data_ = &FlattenConfigResponse{}
@@ -0,0 +1,352 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/Khan/genqlient/internal/testutil"
+)
+
+// InputObjectQueryResponse is returned by InputObjectQuery on success.
+type InputObjectQueryResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User graphql.Omittable[InputObjectQueryUser] `json:"user"`
+}
+
+// GetUser returns InputObjectQueryResponse.User, and is useful for accessing the field via an interface.
+func (v *InputObjectQueryResponse) GetUser() graphql.Omittable[InputObjectQueryUser] { return v.User }
+
+func (v *InputObjectQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*InputObjectQueryResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.InputObjectQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshalInputObjectQueryResponse struct {
+	User *graphql.Omittable[InputObjectQueryUser] `json:"user,omitempty"`
+}
+
+func (v *InputObjectQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *InputObjectQueryResponse) __premarshalJSON() (*__premarshalInputObjectQueryResponse, error) {
+	var retval __premarshalInputObjectQueryResponse
+
+	if v.User.IsSet() {
+		retval.User = &v.User
+	}
+	return &retval, nil
+}
+
+// InputObjectQueryUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type InputObjectQueryUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id string `json:"id"`
+}
+
+// GetId returns InputObjectQueryUser.Id, and is useful for accessing the field via an interface.
+func (v *InputObjectQueryUser) GetId() string { return v.Id }
+
+type PokemonInput struct {
+	Species string `json:"species"`
+	Level   int    `json:"level"`
+}
+
+// GetSpecies returns PokemonInput.Species, and is useful for accessing the field via an interface.
+func (v *PokemonInput) GetSpecies() string { return v.Species }
+
+// GetLevel returns PokemonInput.Level, and is useful for accessing the field via an interface.
+func (v *PokemonInput) GetLevel() int { return v.Level }
+
+// Role is a type a user may have.
+type Role string
+
+const (
+	// What is a student?
+	//
+	// A student is primarily a person enrolled in a school or other educational institution and who is under learning with goals of acquiring knowledge, developing professions and achieving employment at desired field. In the broader sense, a student is anyone who applies themselves to the intensive intellectual engagement with some matter necessary to master it as part of some practical affair in which such mastery is basic or decisive.
+	//
+	// (from [Wikipedia](https://en.wikipedia.org/wiki/Student))
+	RoleStudent Role = "STUDENT"
+	// Teacher is a teacher, who teaches the students.
+	RoleTeacher Role = "TEACHER"
+)
+
+var AllRole = []Role{
+	RoleStudent,
+	RoleTeacher,
+}
+
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
+// UserQueryInput is the argument to Query.users.
+//
+// Ideally this would support anything and everything!
+// Or maybe ideally it wouldn't.
+// Really I'm just talking to make this documentation longer.
+type UserQueryInput struct {
+	Email graphql.Omittable[string] `json:"email"`
+	Name  graphql.Omittable[string] `json:"name"`
+	// id looks the user up by ID.  It's a great way to look up users.
+	Id         graphql.Omittable[string]                      `json:"id"`
+	Role       graphql.Omittable[Role]                        `json:"role"`
+	Names      graphql.Omittable[[]graphql.Omittable[string]] `json:"names"`
+	HasPokemon graphql.Omittable[PokemonInput]                `json:"hasPokemon"`
+	Birthdate  time.Time                                      `json:"-"`
+}
+
+// GetEmail returns UserQueryInput.Email, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetEmail() graphql.Omittable[string] { return v.Email }
+
+// GetName returns UserQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetName() graphql.Omittable[string] { return v.Name }
+
+// GetId returns UserQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetId() graphql.Omittable[string] { return v.Id }
+
+// GetRole returns UserQueryInput.Role, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetRole() graphql.Omittable[Role] { return v.Role }
+
+// GetNames returns UserQueryInput.Names, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetNames() graphql.Omittable[[]graphql.Omittable[string]] { return v.Names }
+
+// GetHasPokemon returns UserQueryInput.HasPokemon, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetHasPokemon() graphql.Omittable[PokemonInput] { return v.HasPokemon }
+
+// GetBirthdate returns UserQueryInput.Birthdate, and is useful for accessing the field via an interface.
+func (v *UserQueryInput) GetBirthdate() time.Time { return v.Birthdate }
+
+func (v *UserQueryInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UserQueryInput
+		Birthdate json.RawMessage `json:"birthdate"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UserQueryInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Birthdate
+		src := firstPass.Birthdate
+		if len(src) != 0 && string(src) != "null" {
+			err = testutil.UnmarshalDate(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UserQueryInput.Birthdate: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalUserQueryInput struct {
+	Email *graphql.Omittable[string] `json:"email,omitempty"`
+
+	Name *graphql.Omittable[string] `json:"name,omitempty"`
+
+	Id *graphql.Omittable[string] `json:"id,omitempty"`
+
+	Role *graphql.Omittable[Role] `json:"role,omitempty"`
+
+	Names *graphql.Omittable[[]graphql.Omittable[string]] `json:"names,omitempty"`
+
+	HasPokemon *graphql.Omittable[PokemonInput] `json:"hasPokemon,omitempty"`
+
+	Birthdate json.RawMessage `json:"birthdate"`
+}
+
+func (v *UserQueryInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UserQueryInput) __premarshalJSON() (*__premarshalUserQueryInput, error) {
+	var retval __premarshalUserQueryInput
+
+	if v.Email.IsSet() {
+		retval.Email = &v.Email
+	}
+	if v.Name.IsSet() {
+		retval.Name = &v.Name
+	}
+	if v.Id.IsSet() {
+		retval.Id = &v.Id
+	}
+	if v.Role.IsSet() {
+		retval.Role = &v.Role
+	}
+	if v.Names.IsSet() {
+		retval.Names = &v.Names
+	}
+	if v.HasPokemon.IsSet() {
+		retval.HasPokemon = &v.HasPokemon
+	}
+	{
+
+		dst := &retval.Birthdate
+		src := v.Birthdate
+		var err error
+		*dst, err = testutil.MarshalDate(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UserQueryInput.Birthdate: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// __InputObjectQueryInput is used internally by genqlient
+type __InputObjectQueryInput struct {
+	Query graphql.Omittable[UserQueryInput] `json:"query"`
+}
+
+// GetQuery returns __InputObjectQueryInput.Query, and is useful for accessing the field via an interface.
+func (v *__InputObjectQueryInput) GetQuery() graphql.Omittable[UserQueryInput] { return v.Query }
+
+func (v *__InputObjectQueryInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*__InputObjectQueryInput
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.__InputObjectQueryInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshal__InputObjectQueryInput struct {
+	Query *graphql.Omittable[UserQueryInput] `json:"query,omitempty"`
+}
+
+func (v *__InputObjectQueryInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *__InputObjectQueryInput) __premarshalJSON() (*__premarshal__InputObjectQueryInput, error) {
+	var retval __premarshal__InputObjectQueryInput
+
+	if v.Query.IsSet() {
+		retval.Query = &v.Query
+	}
+	return &retval, nil
+}
+
+// The query executed by InputObjectQuery.
+const InputObjectQuery_Operation = `
+query InputObjectQuery ($query: UserQueryInput) {
+	user(query: $query) {
+		id
+	}
+}
+`
+
+// The SHA-256 hash of InputObjectQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const InputObjectQuery_OperationHash = "e014b248f8fbb4c1c1af234caddac3ccac15e406513056c9f2461089c87d9716"
+
+func InputObjectQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	query graphql.Omittable[UserQueryInput],
+) (data_ *InputObjectQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "InputObjectQuery",
+		Query:  InputObjectQuery_Operation,
+		Hash:   InputObjectQuery_OperationHash,
+		Variables: &__InputObjectQueryInput{
+			Query: query,
+		},
+	}
+
+	data_ = &InputObjectQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
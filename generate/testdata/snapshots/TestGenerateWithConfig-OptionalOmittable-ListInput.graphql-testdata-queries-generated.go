@@ -0,0 +1,168 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// ListInputQueryResponse is returned by ListInputQuery on success.
+type ListInputQueryResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User graphql.Omittable[ListInputQueryUser] `json:"user"`
+}
+
+// GetUser returns ListInputQueryResponse.User, and is useful for accessing the field via an interface.
+func (v *ListInputQueryResponse) GetUser() graphql.Omittable[ListInputQueryUser] { return v.User }
+
+func (v *ListInputQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListInputQueryResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListInputQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshalListInputQueryResponse struct {
+	User *graphql.Omittable[ListInputQueryUser] `json:"user,omitempty"`
+}
+
+func (v *ListInputQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListInputQueryResponse) __premarshalJSON() (*__premarshalListInputQueryResponse, error) {
+	var retval __premarshalListInputQueryResponse
+
+	if v.User.IsSet() {
+		retval.User = &v.User
+	}
+	return &retval, nil
+}
+
+// ListInputQueryUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type ListInputQueryUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id string `json:"id"`
+}
+
+// GetId returns ListInputQueryUser.Id, and is useful for accessing the field via an interface.
+func (v *ListInputQueryUser) GetId() string { return v.Id }
+
+// __ListInputQueryInput is used internally by genqlient
+type __ListInputQueryInput struct {
+	Names graphql.Omittable[[]graphql.Omittable[string]] `json:"names"`
+}
+
+// GetNames returns __ListInputQueryInput.Names, and is useful for accessing the field via an interface.
+func (v *__ListInputQueryInput) GetNames() graphql.Omittable[[]graphql.Omittable[string]] {
+	return v.Names
+}
+
+func (v *__ListInputQueryInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*__ListInputQueryInput
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.__ListInputQueryInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshal__ListInputQueryInput struct {
+	Names *graphql.Omittable[[]graphql.Omittable[string]] `json:"names,omitempty"`
+}
+
+func (v *__ListInputQueryInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *__ListInputQueryInput) __premarshalJSON() (*__premarshal__ListInputQueryInput, error) {
+	var retval __premarshal__ListInputQueryInput
+
+	if v.Names.IsSet() {
+		retval.Names = &v.Names
+	}
+	return &retval, nil
+}
+
+// The query executed by ListInputQuery.
+const ListInputQuery_Operation = `
+query ListInputQuery ($names: [String]) {
+	user(query: {names:$names}) {
+		id
+	}
+}
+`
+
+// The SHA-256 hash of ListInputQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const ListInputQuery_OperationHash = "426daf556301f116c01dc169d6a3f11479f2a4bd6c1b3a08002d8f06d3642746"
+
+func ListInputQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	names graphql.Omittable[[]graphql.Omittable[string]],
+) (data_ *ListInputQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListInputQuery",
+		Query:  ListInputQuery_Operation,
+		Hash:   ListInputQuery_OperationHash,
+		Variables: &__ListInputQueryInput{
+			Names: names,
+		},
+	}
+
+	data_ = &ListInputQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
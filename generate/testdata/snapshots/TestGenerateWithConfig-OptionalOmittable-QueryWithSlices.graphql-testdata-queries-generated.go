@@ -0,0 +1,181 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// QueryWithSlicesResponse is returned by QueryWithSlices on success.
+type QueryWithSlicesResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User graphql.Omittable[QueryWithSlicesUser] `json:"user"`
+}
+
+// GetUser returns QueryWithSlicesResponse.User, and is useful for accessing the field via an interface.
+func (v *QueryWithSlicesResponse) GetUser() graphql.Omittable[QueryWithSlicesUser] { return v.User }
+
+func (v *QueryWithSlicesResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*QueryWithSlicesResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.QueryWithSlicesResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshalQueryWithSlicesResponse struct {
+	User *graphql.Omittable[QueryWithSlicesUser] `json:"user,omitempty"`
+}
+
+func (v *QueryWithSlicesResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *QueryWithSlicesResponse) __premarshalJSON() (*__premarshalQueryWithSlicesResponse, error) {
+	var retval __premarshalQueryWithSlicesResponse
+
+	if v.User.IsSet() {
+		retval.User = &v.User
+	}
+	return &retval, nil
+}
+
+// QueryWithSlicesUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type QueryWithSlicesUser struct {
+	Emails                []string                                       `json:"emails"`
+	EmailsOrNull          graphql.Omittable[[]string]                    `json:"emailsOrNull"`
+	EmailsWithNulls       []graphql.Omittable[string]                    `json:"emailsWithNulls"`
+	EmailsWithNullsOrNull graphql.Omittable[[]graphql.Omittable[string]] `json:"emailsWithNullsOrNull"`
+}
+
+// GetEmails returns QueryWithSlicesUser.Emails, and is useful for accessing the field via an interface.
+func (v *QueryWithSlicesUser) GetEmails() []string { return v.Emails }
+
+// GetEmailsOrNull returns QueryWithSlicesUser.EmailsOrNull, and is useful for accessing the field via an interface.
+func (v *QueryWithSlicesUser) GetEmailsOrNull() graphql.Omittable[[]string] { return v.EmailsOrNull }
+
+// GetEmailsWithNulls returns QueryWithSlicesUser.EmailsWithNulls, and is useful for accessing the field via an interface.
+func (v *QueryWithSlicesUser) GetEmailsWithNulls() []graphql.Omittable[string] {
+	return v.EmailsWithNulls
+}
+
+// GetEmailsWithNullsOrNull returns QueryWithSlicesUser.EmailsWithNullsOrNull, and is useful for accessing the field via an interface.
+func (v *QueryWithSlicesUser) GetEmailsWithNullsOrNull() graphql.Omittable[[]graphql.Omittable[string]] {
+	return v.EmailsWithNullsOrNull
+}
+
+func (v *QueryWithSlicesUser) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*QueryWithSlicesUser
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.QueryWithSlicesUser = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type __premarshalQueryWithSlicesUser struct {
+	Emails []string `json:"emails"`
+
+	EmailsOrNull *graphql.Omittable[[]string] `json:"emailsOrNull,omitempty"`
+
+	EmailsWithNulls []graphql.Omittable[string] `json:"emailsWithNulls"`
+
+	EmailsWithNullsOrNull *graphql.Omittable[[]graphql.Omittable[string]] `json:"emailsWithNullsOrNull,omitempty"`
+}
+
+func (v *QueryWithSlicesUser) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *QueryWithSlicesUser) __premarshalJSON() (*__premarshalQueryWithSlicesUser, error) {
+	var retval __premarshalQueryWithSlicesUser
+
+	retval.Emails = v.Emails
+	if v.EmailsOrNull.IsSet() {
+		retval.EmailsOrNull = &v.EmailsOrNull
+	}
+	retval.EmailsWithNulls = v.EmailsWithNulls
+	if v.EmailsWithNullsOrNull.IsSet() {
+		retval.EmailsWithNullsOrNull = &v.EmailsWithNullsOrNull
+	}
+	return &retval, nil
+}
+
+// The query executed by QueryWithSlices.
+const QueryWithSlices_Operation = `
+query QueryWithSlices {
+	user {
+		emails
+		emailsOrNull
+		emailsWithNulls
+		emailsWithNullsOrNull
+	}
+}
+`
+
+// The SHA-256 hash of QueryWithSlices_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithSlices_OperationHash = "5d4142d36f8dc723dec90c83e7140d26bbc4b8c6bdb4ab24bad9282b1ce71de6"
+
+func QueryWithSlices(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *QueryWithSlicesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "QueryWithSlices",
+		Query:  QueryWithSlices_Operation,
+		Hash:   QueryWithSlices_OperationHash,
+	}
+
+	data_ = &QueryWithSlicesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
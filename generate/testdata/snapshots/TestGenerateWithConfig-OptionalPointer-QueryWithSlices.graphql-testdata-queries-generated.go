@@ -55,6 +55,10 @@ query QueryWithSlices {
 }
 `
 
+// The SHA-256 hash of QueryWithSlices_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithSlices_OperationHash = "5d4142d36f8dc723dec90c83e7140d26bbc4b8c6bdb4ab24bad9282b1ce71de6"
+
 func QueryWithSlices(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -62,6 +66,7 @@ func QueryWithSlices(
 	req_ := &graphql.Request{
 		OpName: "QueryWithSlices",
 		Query:  QueryWithSlices_Operation,
+		Hash:   QueryWithSlices_OperationHash,
 	}
 
 	data_ = &QueryWithSlicesResponse{}
@@ -48,6 +48,10 @@ query SimpleQueryNoOverride {
 }
 `
 
+// The SHA-256 hash of SimpleQueryNoOverride_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleQueryNoOverride_OperationHash = "1726a0b9a4fdd20dff8564ac1cd39f383b3688f2ff9d30dda31e501a0189fd4c"
+
 func SimpleQueryNoOverride(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -55,6 +59,7 @@ func SimpleQueryNoOverride(
 	req_ := &graphql.Request{
 		OpName: "SimpleQueryNoOverride",
 		Query:  SimpleQueryNoOverride_Operation,
+		Hash:   SimpleQueryNoOverride_OperationHash,
 	}
 
 	data_ = &SimpleQueryNoOverrideResponse{}
@@ -50,6 +50,10 @@ query SimpleQueryWithPointerFalseOverride {
 }
 `
 
+// The SHA-256 hash of SimpleQueryWithPointerFalseOverride_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleQueryWithPointerFalseOverride_OperationHash = "29e87ff9bf7fe302e5d143ede9b7be1eb9a3770ce2fd2bb3edd76593de2b981a"
+
 func SimpleQueryWithPointerFalseOverride(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -57,6 +61,7 @@ func SimpleQueryWithPointerFalseOverride(
 	req_ := &graphql.Request{
 		OpName: "SimpleQueryWithPointerFalseOverride",
 		Query:  SimpleQueryWithPointerFalseOverride_Operation,
+		Hash:   SimpleQueryWithPointerFalseOverride_OperationHash,
 	}
 
 	data_ = &SimpleQueryWithPointerFalseOverrideResponse{}
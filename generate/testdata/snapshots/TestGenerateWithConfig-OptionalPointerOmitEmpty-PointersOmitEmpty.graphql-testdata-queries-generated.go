@@ -173,6 +173,29 @@ var AllRole = []Role{
 	RoleTeacher,
 }
 
+// IsValid returns true if v is one of the values of Role known when this code was generated.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleStudent, RoleTeacher:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Role) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
 // UserQueryInput is the argument to Query.users.
 //
 // Ideally this would support anything and everything!
@@ -399,6 +422,10 @@ query PointersOmitEmptyQuery ($query: UserQueryInput, $dt: DateTime, $tz: String
 }
 `
 
+// The SHA-256 hash of PointersOmitEmptyQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const PointersOmitEmptyQuery_OperationHash = "64b38199087fef5497c8a91310f78b6bfb84b8b3581c7dfaaaf84d1a75a8e893"
+
 func PointersOmitEmptyQuery(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -409,6 +436,7 @@ func PointersOmitEmptyQuery(
 	req_ := &graphql.Request{
 		OpName: "PointersOmitEmptyQuery",
 		Query:  PointersOmitEmptyQuery_Operation,
+		Hash:   PointersOmitEmptyQuery_OperationHash,
 		Variables: &__PointersOmitEmptyQueryInput{
 			Query: query,
 			Dt:    dt,
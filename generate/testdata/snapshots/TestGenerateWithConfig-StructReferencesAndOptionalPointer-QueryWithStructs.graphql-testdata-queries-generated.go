@@ -57,6 +57,10 @@ query QueryWithStructs {
 }
 `
 
+// The SHA-256 hash of QueryWithStructs_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const QueryWithStructs_OperationHash = "a9f9b2a3048c540188752153837017ebd5f9cfd71ceafa610990bc5d78a5ddc2"
+
 func QueryWithStructs(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -64,6 +68,7 @@ func QueryWithStructs(
 	req_ := &graphql.Request{
 		OpName: "QueryWithStructs",
 		Query:  QueryWithStructs_Operation,
+		Hash:   QueryWithStructs_OperationHash,
 	}
 
 	data_ = &QueryWithStructsResponse{}
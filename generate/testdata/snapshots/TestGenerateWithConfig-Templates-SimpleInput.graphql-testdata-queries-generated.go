@@ -0,0 +1,85 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package queries
+
+import (
+	"context"
+	"log"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// SimpleInputQueryResponse is returned by SimpleInputQuery on success.
+type SimpleInputQueryResponse struct {
+	// user looks up a user by some stuff.
+	//
+	// See UserQueryInput for what stuff is supported.
+	// If query is null, returns the current user.
+	User SimpleInputQueryUser `json:"user" graphql:"user"`
+}
+
+// GetUser returns SimpleInputQueryResponse.User, and is useful for accessing the field via an interface.
+func (v *SimpleInputQueryResponse) GetUser() SimpleInputQueryUser { return v.User }
+
+// SimpleInputQueryUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A User is a user!
+type SimpleInputQueryUser struct {
+	// id is the user's ID.
+	//
+	// It is stable, unique, and opaque, like all good IDs.
+	Id string `json:"id" graphql:"id"`
+}
+
+// GetId returns SimpleInputQueryUser.Id, and is useful for accessing the field via an interface.
+func (v *SimpleInputQueryUser) GetId() string { return v.Id }
+
+// __SimpleInputQueryInput is used internally by genqlient
+type __SimpleInputQueryInput struct {
+	Name string `json:"name" graphql:"name"`
+}
+
+// GetName returns __SimpleInputQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *__SimpleInputQueryInput) GetName() string { return v.Name }
+
+// The query executed by SimpleInputQuery.
+const SimpleInputQuery_Operation = `
+query SimpleInputQuery ($name: String!) {
+	user(query: {name:$name}) {
+		id
+	}
+}
+`
+
+// The SHA-256 hash of SimpleInputQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const SimpleInputQuery_OperationHash = "84ce0c464ccde445c4d1597dd7517a3fe6d4b520f7ddfbf3909ea28bef900cde"
+
+func SimpleInputQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	name string,
+) (data_ *SimpleInputQueryResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SimpleInputQuery",
+		Query:  SimpleInputQuery_Operation,
+		Hash:   SimpleInputQuery_OperationHash,
+		Variables: &__SimpleInputQueryInput{
+			Name: name,
+		},
+	}
+
+	data_ = &SimpleInputQueryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	log.Printf("making request %v", req_.OpName)
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
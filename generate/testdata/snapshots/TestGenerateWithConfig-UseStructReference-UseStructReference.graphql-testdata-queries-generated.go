@@ -63,6 +63,10 @@ query UseStructReference ($input: UseStructReferencesInput!) {
 }
 `
 
+// The SHA-256 hash of UseStructReference_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const UseStructReference_OperationHash = "076215daf73c6ccdfdc186ad6637dd56485674ecf64458b5717f3e4f8df5b524"
+
 // https://github.com/Khan/genqlient/issues/342
 func UseStructReference(
 	ctx_ context.Context,
@@ -72,6 +76,7 @@ func UseStructReference(
 	req_ := &graphql.Request{
 		OpName: "UseStructReference",
 		Query:  UseStructReference_Operation,
+		Hash:   UseStructReference_OperationHash,
 		Variables: &__UseStructReferenceInput{
 			Input: input,
 		},
@@ -56,6 +56,7 @@ var (
 	_ goType = (*goStructType)(nil)
 	_ goType = (*goInterfaceType)(nil)
 	_ goType = (*goGenericType)(nil)
+	_ goType = (*goFlattenedType)(nil)
 )
 
 type (
@@ -86,6 +87,10 @@ type (
 	goGenericType struct {
 		GoGenericRef string
 		Elem         goType
+		// True if this is graphql.Omittable (see optional: omittable in
+		// genqlient.yaml), in which case we omit unset values when
+		// marshaling.
+		Omittable bool
 	}
 )
 
@@ -122,12 +127,14 @@ func (typ *goGenericType) GraphQLTypeName() string            { return typ.Elem.
 
 // goEnumType represents a Go named-string type used to represent a GraphQL
 // enum.  In this case, we generate both the type (`type T string`) and also a
-// list of consts representing the values.
+// list of consts representing the values, along with some helper methods.
 type goEnumType struct {
 	GoName      string
 	GraphQLName string
 	Description string
 	Values      []goEnumValue
+	// How to unmarshal values not in Values; see Config.UnknownEnumValues.
+	UnknownValues string
 }
 
 type goEnumValue struct {
@@ -136,6 +143,12 @@ type goEnumValue struct {
 	Description string
 }
 
+// unknownValueGoName returns the name of the constant to which we map unknown
+// values, if UnknownValues is "map".
+func (typ *goEnumType) unknownValueGoName() string {
+	return typ.GoName + "Unknown"
+}
+
 func (typ *goEnumType) WriteDefinition(w io.Writer, g *generator) error {
 	// All GraphQL enums have underlying type string (in the Go sense).
 	writeDescription(w, typ.Description)
@@ -146,6 +159,13 @@ func (typ *goEnumType) WriteDefinition(w io.Writer, g *generator) error {
 		fmt.Fprintf(w, "%s %s = \"%s\"\n",
 			val.GoName, typ.GoName, val.GraphQLName)
 	}
+	if typ.UnknownValues == "map" {
+		writeDescription(w, fmt.Sprintf(
+			"%s is the value to which unmarshaling maps values of %s not "+
+				"known when this code was generated.  It is also the zero value.",
+			typ.unknownValueGoName(), typ.GraphQLName))
+		fmt.Fprintf(w, "%s %s = \"\"\n", typ.unknownValueGoName(), typ.GoName)
+	}
 	fmt.Fprintf(w, ")\n")
 
 	// Add slice with all enums.
@@ -153,7 +173,56 @@ func (typ *goEnumType) WriteDefinition(w io.Writer, g *generator) error {
 	for _, val := range typ.Values {
 		fmt.Fprintf(w, "%s,\n", val.GoName)
 	}
-	fmt.Fprintf(w, "}\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "// IsValid returns true if v is one of the values of %s known "+
+		"when this code was generated.\n", typ.GraphQLName)
+	fmt.Fprintf(w, "func (v %s) IsValid() bool {\n", typ.GoName)
+	fmt.Fprintf(w, "switch v {\ncase ")
+	for i, val := range typ.Values {
+		if i > 0 {
+			fmt.Fprintf(w, ", ")
+		}
+		fmt.Fprintf(w, "%s", val.GoName)
+	}
+	fmt.Fprintf(w, ":\nreturn true\ndefault:\nreturn false\n}\n}\n\n")
+
+	fmt.Fprintf(w, "// String returns the GraphQL value of v.\n")
+	fmt.Fprintf(w, "func (v %s) String() string { return string(v) }\n\n", typ.GoName)
+
+	fmt.Fprintf(w, "// MarshalText implements encoding.TextMarshaler.\n")
+	fmt.Fprintf(w, "func (v %s) MarshalText() ([]byte, error) { return []byte(v), nil }\n\n",
+		typ.GoName)
+
+	switch typ.UnknownValues {
+	case "map":
+		fmt.Fprintf(w, "// UnmarshalText implements encoding.TextUnmarshaler.\n// Values "+
+			"not known when this code was generated are mapped to %s.\n",
+			typ.unknownValueGoName())
+		fmt.Fprintf(w, "func (v *%s) UnmarshalText(text []byte) error {\n", typ.GoName)
+		fmt.Fprintf(w, "*v = %s(text)\n", typ.GoName)
+		fmt.Fprintf(w, "if !v.IsValid() {\n*v = %s\n}\n", typ.unknownValueGoName())
+		fmt.Fprintf(w, "return nil\n}\n")
+	case "reject":
+		errorf, err := g.ref("fmt.Errorf")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "// UnmarshalText implements encoding.TextUnmarshaler.\n// Values "+
+			"not known when this code was generated are an error.\n")
+		fmt.Fprintf(w, "func (v *%s) UnmarshalText(text []byte) error {\n", typ.GoName)
+		fmt.Fprintf(w, "val := %s(text)\n", typ.GoName)
+		fmt.Fprintf(w, "if !val.IsValid() {\n")
+		fmt.Fprintf(w, "return %s(\"unknown value for enum %s: %%q\", text)\n}\n",
+			errorf, typ.GraphQLName)
+		fmt.Fprintf(w, "*v = val\nreturn nil\n}\n")
+	default: // "keep"
+		fmt.Fprintf(w, "// UnmarshalText implements encoding.TextUnmarshaler.\n// Values "+
+			"not known when this code was generated are kept as-is; use "+
+			"IsValid to check for them.\n")
+		fmt.Fprintf(w, "func (v *%s) UnmarshalText(text []byte) error {\n", typ.GoName)
+		fmt.Fprintf(w, "*v = %s(text)\nreturn nil\n}\n", typ.GoName)
+	}
 	return nil
 }
 
@@ -161,24 +230,85 @@ func (typ *goEnumType) Reference() string              { return typ.GoName }
 func (typ *goEnumType) SelectionSet() ast.SelectionSet { return nil }
 func (typ *goEnumType) GraphQLTypeName() string        { return typ.GraphQLName }
 
+// goFlattenedType represents the type of a field with the flatten option
+// whose selection is a single field, like `viewer { user { id } }`.  In Go,
+// the type is just that of the inner field (Elem), but the JSON has an extra
+// layer of nesting.  To handle that, we generate Wrapper, a struct-type with
+// just the inner field, along with marshal- and unmarshal-helpers which go
+// through it.  (Since the inner field may itself need special handling, this
+// is much simpler than doing the same thing directly.)
+type goFlattenedType struct {
+	Wrapper *goStructType
+	Elem    goType
+}
+
+func (typ *goFlattenedType) WriteDefinition(w io.Writer, g *generator) error {
+	err := typ.Wrapper.WriteDefinition(w, g)
+	if err != nil {
+		return err
+	}
+
+	jsonUnmarshal, err := g.ref("encoding/json.Unmarshal")
+	if err != nil {
+		return err
+	}
+	jsonMarshal, err := g.ref("encoding/json.Marshal")
+	if err != nil {
+		return err
+	}
+	fieldName := typ.Wrapper.Fields[0].GoName
+	fmt.Fprintf(w, "\nfunc %s(b []byte, v *%s) error {\n",
+		typ.unmarshalerName(), typ.Elem.Reference())
+	fmt.Fprintf(w, "var wrapper %s\n", typ.Wrapper.GoName)
+	fmt.Fprintf(w, "err := %s(b, &wrapper)\n", jsonUnmarshal)
+	fmt.Fprintf(w, "*v = wrapper.%s\n", fieldName)
+	fmt.Fprintf(w, "return err\n}\n\n")
+
+	fmt.Fprintf(w, "func %s(v *%s) ([]byte, error) {\n",
+		typ.marshalerName(), typ.Elem.Reference())
+	fmt.Fprintf(w, "return %s(&%s{%s: *v})\n}\n",
+		jsonMarshal, typ.Wrapper.GoName, fieldName)
+	return nil
+}
+
+func (typ *goFlattenedType) unmarshalerName() string {
+	return "__unmarshalFlattened" + strings.TrimPrefix(typ.Wrapper.GoName, "__flattened")
+}
+
+func (typ *goFlattenedType) marshalerName() string {
+	return "__marshalFlattened" + strings.TrimPrefix(typ.Wrapper.GoName, "__flattened")
+}
+
+func (typ *goFlattenedType) Reference() string              { return typ.Elem.Reference() }
+func (typ *goFlattenedType) SelectionSet() ast.SelectionSet { return typ.Wrapper.Selection }
+func (typ *goFlattenedType) GraphQLTypeName() string        { return typ.Wrapper.GraphQLName }
+
 // goStructType represents a Go struct type used to represent a GraphQL object
 // or input-object type.
 type goStructType struct {
-	GoName    string
-	Fields    []*goStructField
-	IsInput   bool
+	GoName  string
+	Fields  []*goStructField
+	IsInput bool
+	// True if GoName was given by the typename option.
+	UserNamed bool
 	Selection ast.SelectionSet
 	descriptionInfo
 	Generator *generator // for the convenience of the template
 }
 
+// Description returns the doc-comment for the type.
+func (typ *goStructType) Description() string {
+	return structDescription(typ)
+}
+
 type goStructField struct {
 	GoName      string
 	GoType      goType
 	JSONName    string // i.e. the field's alias in this query
 	GraphQLName string // i.e. the field's name in its type-def
 	Omitempty   bool   // only used on input types
-	Description string
+	Description string // includes the deprecation, if any
+	Deprecation string // the reason the field is deprecated, if it is
 }
 
 // IsAbstract returns true if this field is of abstract type (i.e. GraphQL
@@ -194,6 +324,19 @@ func (field *goStructField) IsEmbedded() bool {
 	return field.GoName == ""
 }
 
+// JSONTag returns the value of the field's json struct tag.
+func (field *goStructField) JSONTag() string {
+	if field.NeedsMarshaling() {
+		// certain types are handled in our (Un)MarshalJSON (see
+		// goStructType.WriteDefinition)
+		return "-"
+	}
+	if field.Omitempty {
+		return field.JSONName + ",omitempty"
+	}
+	return field.JSONName
+}
+
 // Selector returns the field's name, which is unqualified type-name if it's
 // embedded.
 func (field *goStructField) Selector() string {
@@ -219,6 +362,8 @@ func (field *goStructField) unmarshaler() (qualifiedName string, needsImport boo
 		}
 	case *goInterfaceType:
 		return "__unmarshal" + typ.Reference(), false, true
+	case *goFlattenedType:
+		return typ.unmarshalerName(), false, true
 	}
 	return "encoding/json.Unmarshal", true, field.IsEmbedded()
 }
@@ -245,6 +390,8 @@ func (field *goStructField) marshaler() (qualifiedName string, needsImport bool,
 		}
 	case *goInterfaceType:
 		return "__marshal" + typ.Reference(), false, true
+	case *goFlattenedType:
+		return typ.marshalerName(), false, true
 	}
 	return "encoding/json.Marshal", true, field.IsEmbedded()
 }
@@ -270,11 +417,20 @@ func (field *goStructField) NeedsMarshaling() bool {
 	return ok1 || ok2
 }
 
+// IsOmittable returns true if this field is of type graphql.Omittable, in
+// which case we need to omit it when marshaling if it's unset.  (Otherwise,
+// the JSON library handles it fine; it's not NeedsMarshaling.)
+func (field *goStructField) IsOmittable() bool {
+	typ, ok := field.GoType.(*goGenericType)
+	return ok && typ.Omittable
+}
+
 // NeedsMarshaler returns true if any fields of this type need special
-// handling when (un)marshaling (see goStructField.NeedsMarshaling).
+// handling when (un)marshaling (see goStructField.NeedsMarshaling and
+// goStructField.IsOmittable).
 func (typ *goStructType) NeedsMarshaling() bool {
 	for _, f := range typ.Fields {
-		if f.NeedsMarshaling() {
+		if f.NeedsMarshaling() || f.IsOmittable() {
 			return true
 		}
 	}
@@ -385,26 +541,10 @@ func (typ *goStructType) FlattenedFields() ([]*selector, error) {
 }
 
 func (typ *goStructType) WriteDefinition(w io.Writer, g *generator) error {
-	writeDescription(w, structDescription(typ))
-
-	fmt.Fprintf(w, "type %s struct {\n", typ.GoName)
-	for _, field := range typ.Fields {
-		writeDescription(w, field.Description)
-		jsonTag := `"` + field.JSONName
-		if field.Omitempty {
-			jsonTag += ",omitempty"
-		}
-		jsonTag += `"`
-		if field.NeedsMarshaling() {
-			// certain types are handled in our (Un)MarshalJSON (see below)
-			jsonTag = `"-"`
-		}
-		// Note for embedded types field.GoName is "", which produces the code
-		// we want!
-		fmt.Fprintf(w, "\t%s %s `json:%s`\n",
-			field.GoName, field.GoType.Reference(), jsonTag)
+	err := g.render("struct.go.tmpl", w, typ)
+	if err != nil {
+		return err
 	}
-	fmt.Fprintf(w, "}\n")
 
 	// Write out getter methods for each field.  These are most useful for
 	// shared fields of an interface -- the methods will be included in the
@@ -424,7 +564,7 @@ func (typ *goStructType) WriteDefinition(w io.Writer, g *generator) error {
 		description := fmt.Sprintf(
 			"Get%s returns %s.%s, and is useful for accessing the field via an interface.",
 			field.GoName, typ.GoName, field.GoName)
-		writeDescription(w, description)
+		writeDescription(w, withDeprecationReason(description, field.Deprecation))
 		fmt.Fprintf(w, "func (v *%s) Get%s() %s { return v.%s }\n",
 			typ.GoName, field.GoName, field.GoType.Reference(), field.Selector)
 	}
@@ -486,7 +626,11 @@ type goInterfaceType struct {
 	// we'll generate getter methods for each.
 	SharedFields    []*goStructField
 	Implementations []*goStructType
-	Selection       ast.SelectionSet
+	// The implementation to use for concrete types not in the schema when
+	// we generated the code, or nil if unknown types are an error.  (See
+	// the unknown_implementations option in genqlient_directive.graphql.)
+	Unknown   *goStructType
+	Selection ast.SelectionSet
 	descriptionInfo
 }
 
@@ -530,6 +674,10 @@ func (typ *goInterfaceType) WriteDefinition(w io.Writer, g *generator) error {
 		fmt.Fprintf(w, "func (v *%s) %s() {}\n",
 			impl.Reference(), implementsMethodName)
 	}
+	if typ.Unknown != nil {
+		fmt.Fprintf(w, "func (v *%s) %s() {}\n",
+			typ.Unknown.Reference(), implementsMethodName)
+	}
 
 	// Finally, write the marshal- and unmarshal-helpers, which
 	// will be called by struct fields referencing this type (see
@@ -553,6 +701,7 @@ func (typ *goGenericType) Unwrap() goType            { return typ.Elem.Unwrap()
 func (typ *goEnumType) Unwrap() goType               { return typ }
 func (typ *goStructType) Unwrap() goType             { return typ }
 func (typ *goInterfaceType) Unwrap() goType          { return typ }
+func (typ *goFlattenedType) Unwrap() goType          { return typ }
 
 func (typ *goOpaqueType) SliceDepth() int             { return 0 }
 func (typ *goTypenameForBuiltinType) SliceDepth() int { return 0 }
@@ -562,6 +711,7 @@ func (typ *goGenericType) SliceDepth() int            { return 0 }
 func (typ *goEnumType) SliceDepth() int               { return 0 }
 func (typ *goStructType) SliceDepth() int             { return 0 }
 func (typ *goInterfaceType) SliceDepth() int          { return 0 }
+func (typ *goFlattenedType) SliceDepth() int          { return 0 }
 
 func (typ *goOpaqueType) IsPointer() bool             { return false }
 func (typ *goTypenameForBuiltinType) IsPointer() bool { return false }
@@ -571,6 +721,7 @@ func (typ *goGenericType) IsPointer() bool            { return false }
 func (typ *goEnumType) IsPointer() bool               { return false }
 func (typ *goStructType) IsPointer() bool             { return false }
 func (typ *goInterfaceType) IsPointer() bool          { return false }
+func (typ *goFlattenedType) IsPointer() bool          { return false }
 
 func writeDescription(w io.Writer, desc string) {
 	if desc != "" {
@@ -28,6 +28,24 @@ func upperFirst(s string) string {
 	return changeFirst(strings.TrimLeft(s, "_"), unicode.ToUpper)
 }
 
+// camelToSnake converts a camelCase or PascalCase name to snake_case, for use
+// in filenames; for example MyHTTPQuery becomes my_http_query.
+func camelToSnake(s string) string {
+	runes := []rune(s)
+	var result strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				result.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
 func snakeToCamel(s string) string {
 	var result strings.Builder
 	var nextUpper bool
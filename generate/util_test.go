@@ -62,3 +62,18 @@ func TestGoConstName(t *testing.T) {
 
 	testStringFunc(t, goConstName, tests)
 }
+
+func TestCamelToSnake(t *testing.T) {
+	tests := []test{
+		{"Empty", "", ""},
+		{"Lower", "asdf", "asdf"},
+		{"Camel", "getUser", "get_user"},
+		{"Pascal", "GetUser", "get_user"},
+		{"Initialism", "GetHTTPUser", "get_http_user"},
+		{"TrailingInitialism", "GetUserByID", "get_user_by_id"},
+		{"Digits", "GetUser2Name", "get_user2_name"},
+		{"Underscore", "Get_User", "get_user"},
+	}
+
+	testStringFunc(t, camelToSnake, tests)
+}
@@ -0,0 +1,248 @@
+package generate
+
+// This file implements --watch mode, which regenerates code whenever its
+// inputs change.  To avoid extra dependencies, we just poll the
+// modification-times of the files, which is plenty fast for the handful of
+// files genqlient typically reads.
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// watchInterval is how often we check for changes.
+	watchInterval = 500 * time.Millisecond
+	// watchDebounce is how long files must go unchanged before we regenerate,
+	// so that we don't regenerate several times when, say, a branch switch
+	// changes several files.
+	watchDebounce = 200 * time.Millisecond
+)
+
+// watchKind describes what we need to redo when a watched file changes;
+// each kind implies all the ones before it.
+type watchKind int
+
+const (
+	// watchNone means nothing changed.
+	watchNone watchKind = iota
+	// watchOperations is for operations and templates: we just need to
+	// regenerate.
+	watchOperations
+	// watchSchema is for the schema: we need to re-parse it.
+	watchSchema
+	// watchConfig is for the config and package_bindings sources: we need
+	// to reload the config, which resolves the bindings, and start over.
+	watchConfig
+)
+
+type watchedFile struct {
+	kind    watchKind
+	modTime time.Time
+	size    int64
+}
+
+// watcher holds the state of --watch mode between regenerations.
+type watcher struct {
+	configFilename string
+
+	// config and schema are the last successfully loaded config and schema,
+	// or nil if loading failed (or we haven't yet).
+	config *Config
+	schema *ast.Schema
+	// bindingDirs are the directories of the package_bindings packages.
+	bindingDirs []string
+
+	// files are the watched files, as of the last regeneration.
+	files map[string]watchedFile
+}
+
+func newWatcher(configFilename string) *watcher {
+	return &watcher{configFilename: configFilename}
+}
+
+// stat returns the current state of all the files we should be watching,
+// given the current config.
+func (w *watcher) stat() map[string]watchedFile {
+	files := map[string]watchedFile{}
+	add := func(kind watchKind, filenames ...string) {
+		for _, filename := range filenames {
+			info, err := os.Stat(filename)
+			if err != nil {
+				// Most likely the file was deleted; if it matters,
+				// we'll report it when we regenerate.
+				continue
+			}
+			// If a file is watched for several reasons, use the one that
+			// requires the most work.
+			fileKind := kind
+			if existing, ok := files[filename]; ok {
+				fileKind = max(fileKind, existing.kind)
+			}
+			files[filename] = watchedFile{fileKind, info.ModTime(), info.Size()}
+		}
+	}
+	// expandFilenames complains if a glob matches nothing; here we just want
+	// whatever does match.
+	addGlobs := func(kind watchKind, globs StringList) {
+		for _, glob := range globs {
+			filenames, _ := expandFilenames([]string{glob})
+			add(kind, filenames...)
+		}
+	}
+
+	add(watchConfig, w.configFilename)
+	for _, dir := range w.bindingDirs {
+		filenames, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+		add(watchConfig, filenames...)
+	}
+	if w.config == nil {
+		return files
+	}
+
+	addGlobs(watchSchema, w.config.Schema)
+	for _, config := range w.config.allProjects() {
+		addGlobs(watchOperations, config.Operations)
+		add(watchOperations, config.Templates...)
+	}
+	return files
+}
+
+// changes returns what we need to redo, given the files have changed from
+// before to after.
+func changes(before, after map[string]watchedFile) watchKind {
+	kind := watchNone
+	for filename, file := range after {
+		if beforeFile, ok := before[filename]; !ok || beforeFile != file {
+			kind = max(kind, file.kind)
+		}
+	}
+	for filename, file := range before {
+		if _, ok := after[filename]; !ok {
+			kind = max(kind, file.kind)
+		}
+	}
+	return kind
+}
+
+// wait blocks until some watched file changes, and then until the watched
+// files stop changing, and returns what we need to redo.
+func (w *watcher) wait() watchKind {
+	var files map[string]watchedFile
+	for {
+		time.Sleep(watchInterval)
+		files = w.stat()
+		if changes(w.files, files) != watchNone {
+			break
+		}
+	}
+
+	for {
+		time.Sleep(watchDebounce)
+		next := w.stat()
+		if changes(files, next) == watchNone {
+			return changes(w.files, next)
+		}
+		files = next
+	}
+}
+
+// regenerate redoes whatever is needed for the given kind of change, writes
+// any generated files whose content changed, and returns their names.
+func (w *watcher) regenerate(kind watchKind) ([]string, error) {
+	if kind >= watchConfig || w.config == nil {
+		w.config, w.schema, w.bindingDirs = nil, nil, nil
+		// Even if the config is invalid, we want to watch it.
+		w.files = w.stat()
+
+		config, err := ReadAndValidateConfig(w.configFilename)
+		if err != nil {
+			return nil, err
+		}
+		w.config = config
+		w.bindingDirs, err = w.packageBindingDirs()
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Note we stat the files before reading them, so that if they change
+	// while we're regenerating, we'll notice next time.
+	w.files = w.stat()
+
+	if kind >= watchSchema || w.schema == nil {
+		w.schema = nil
+		schema, err := getSchema(w.config.Schema)
+		if err != nil {
+			return nil, err
+		}
+		w.schema = schema
+	}
+
+	generated, err := generateWithSchema(w.config, w.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := map[string][]byte{}
+	for filename, content := range generated {
+		existing, readErr := os.ReadFile(filename)
+		if readErr != nil || !bytes.Equal(existing, content) {
+			changed[filename] = content
+		}
+	}
+	stale, err := staleGeneratedFiles(w.config, generated)
+	if err != nil {
+		return nil, err
+	}
+	err = writeGenerated(changed, stale)
+	if err != nil {
+		return nil, err
+	}
+
+	filenames := make([]string, 0, len(changed))
+	for filename := range changed {
+		filenames = append(filenames, filename)
+		// If we're watching a file we wrote (say, if operations are read
+		// from all the Go files in a directory), don't count our own change.
+		if file, ok := w.files[filename]; ok {
+			if info, statErr := os.Stat(filename); statErr == nil {
+				file.modTime, file.size = info.ModTime(), info.Size()
+				w.files[filename] = file
+			}
+		}
+	}
+	sort.Strings(filenames)
+	return filenames, nil
+}
+
+// packageBindingDirs returns the source directories of the packages in
+// package_bindings, so that we can watch them for changes.
+func (w *watcher) packageBindingDirs() ([]string, error) {
+	var packageNames []string
+	for _, config := range w.config.allProjects() {
+		for _, binding := range config.PackageBindings {
+			packageNames = append(packageNames, binding.Package)
+		}
+	}
+	if len(packageNames) == 0 {
+		return nil, nil
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedFiles}, packageNames...)
+	if err != nil {
+		return nil, errorf(nil, "unable to find package_bindings sources: %v", err)
+	}
+	var dirs []string
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) > 0 {
+			dirs = append(dirs, filepath.Dir(pkg.GoFiles[0]))
+		}
+	}
+	return dirs, nil
+}
@@ -0,0 +1,128 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+	dir := t.TempDir()
+	configFilename := filepath.Join(dir, "genqlient.yaml")
+	schemaFilename := filepath.Join(dir, "schema.graphql")
+	operationsFilename := filepath.Join(dir, "operations.graphql")
+	generatedFilename := filepath.Join(dir, "generated.go")
+
+	// modTime makes sure each write has a new modification-time, even if
+	// the filesystem's timestamps are coarse.
+	modTime := time.Now()
+	write := func(filename, content string) {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		modTime = modTime.Add(time.Second)
+		if err := os.Chtimes(filename, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(configFilename, "schema: schema.graphql\noperations: [operations.graphql]\npackage: watch\n")
+	write(schemaFilename, "type Query { f: String, g: Int }\n")
+	write(operationsFilename, "query Q { f }\n")
+
+	w := newWatcher(configFilename)
+	regenerate := func(kind watchKind, wantFilenames ...string) {
+		t.Helper()
+		filenames, err := w.regenerate(kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(filenames, wantFilenames) {
+			t.Errorf("got filenames %v, want %v", filenames, wantFilenames)
+		}
+	}
+	expectChanges := func(want watchKind) {
+		t.Helper()
+		if got := changes(w.files, w.stat()); got != want {
+			t.Errorf("got changes %v, want %v", got, want)
+		}
+	}
+
+	regenerate(watchConfig, generatedFilename)
+	expectChanges(watchNone)
+	// Nothing changed, so nothing is written.
+	regenerate(watchOperations)
+
+	schema := w.schema
+	write(operationsFilename, "query Q { g }\n")
+	expectChanges(watchOperations)
+	regenerate(watchOperations, generatedFilename)
+	if w.schema != schema {
+		t.Error("schema was re-parsed, but only operations changed")
+	}
+
+	// An invalid operation is reported, but we keep watching.
+	write(operationsFilename, "query Q { h }\n")
+	expectChanges(watchOperations)
+	if _, err := w.regenerate(watchOperations); err == nil {
+		t.Error("got no error for invalid operation")
+	}
+	expectChanges(watchNone)
+	write(operationsFilename, "query Q { f }\n")
+	expectChanges(watchOperations)
+	regenerate(watchOperations, generatedFilename)
+
+	write(schemaFilename, "type Query { f: Int }\n")
+	expectChanges(watchSchema)
+	regenerate(watchSchema, generatedFilename)
+	if w.schema == schema {
+		t.Error("schema was not re-parsed")
+	}
+
+	// A change to the schema and the config is a change to the config.
+	write(schemaFilename, "type Query { f: Boolean }\n")
+	write(configFilename, "schema: schema.graphql\noperations: [operations.graphql]\npackage: watch\nuse_struct_references: true\n")
+	expectChanges(watchConfig)
+	regenerate(watchConfig, generatedFilename)
+
+	// Deleting a file counts as a change.
+	if err := os.Remove(operationsFilename); err != nil {
+		t.Fatal(err)
+	}
+	expectChanges(watchOperations)
+}
+
+// TestWatcherStat tests that a file watched for several reasons gets the kind
+// requiring the most work, without affecting other files.
+func TestWatcherStat(t *testing.T) {
+	dir := t.TempDir()
+	configFilename := filepath.Join(dir, "genqlient.yaml")
+	// The schema sorts first, so that the operations glob matches it before
+	// the operations.
+	schemaFilename := filepath.Join(dir, "a_schema.graphql")
+	operationsFilename := filepath.Join(dir, "operations.graphql")
+	for _, filename := range []string{configFilename, schemaFilename, operationsFilename} {
+		if err := os.WriteFile(filename, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := newWatcher(configFilename)
+	w.config = &Config{
+		Schema:     StringList{schemaFilename},
+		Operations: StringList{filepath.Join(dir, "*.graphql")},
+		Templates:  StringList{configFilename},
+	}
+	files := w.stat()
+	for filename, want := range map[string]watchKind{
+		configFilename:     watchConfig,
+		schemaFilename:     watchSchema,
+		operationsFilename: watchOperations,
+	} {
+		if got := files[filename].kind; got != want {
+			t.Errorf("got kind %v for %v, want %v", got, filepath.Base(filename), want)
+		}
+	}
+}
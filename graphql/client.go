@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -46,6 +48,10 @@ type WebSocketClient interface {
 	//
 	// errChan is a channel on which are sent the errors of webSocket
 	// communication. It will be closed when calling the `Close()` method.
+	// If the server closes the connection, the error will be a
+	// [*WebSocketCloseError]; if it violates the protocol, a
+	// [*WebSocketProtocolError] (or, for the client returned by
+	// [NewClientUsingMultipartHTTP], a [*MultipartProtocolError]).
 	//
 	// err is any error that occurs when setting up the webSocket connection.
 	Start(ctx context.Context) (errChan chan error, err error)
@@ -150,6 +156,9 @@ func NewClientUsingWebSocket(endpoint string, wsDialer Dialer, opts ...WebSocket
 		client.header.Add("Sec-WebSocket-Protocol", "graphql-transport-ws")
 	}
 
+	if client.lazy {
+		return newLazyWebSocketClient(client)
+	}
 	return client
 }
 
@@ -168,6 +177,26 @@ func WithWebsocketHeader(header http.Header) WebSocketOption {
 	}
 }
 
+// WithLazyConnect makes the client connect lazily, similar to the `lazy`
+// option of the graphql-ws JavaScript client.
+//
+// Instead of connecting in Start, the client connects on the first call to
+// Subscribe, and keeps the connection open as long as any subscriptions are
+// active. Once it has had no active subscriptions for idleTimeout, it closes
+// the connection; the next call to Subscribe will open a new one. This makes
+// it easy to share a single client as a long-lived dependency.
+//
+// Calling Start is optional for such a client: it does not connect, but
+// returns a channel on which errors from all connections will be sent. If a
+// connection fails, the channels of its subscriptions are closed, and the
+// next call to Subscribe will reconnect.
+func WithLazyConnect(idleTimeout time.Duration) WebSocketOption {
+	return func(ws *webSocketClient) {
+		ws.lazy = true
+		ws.lazyIdleTimeout = idleTimeout
+	}
+}
+
 func newClient(endpoint string, httpClient Doer, method string) Client {
 	if httpClient == nil || httpClient == (*http.Client)(nil) {
 		httpClient = http.DefaultClient
@@ -190,6 +219,10 @@ type Dialer interface {
 
 // WSConn encapsulates basic methods for a webSocket connection, taking model on
 // [github.com/gorilla/websocket] [*websocket.Conn]
+//
+// When the server closes the connection, ReadMessage should return (or wrap)
+// an error with fields Code and Text, like gorilla's [*websocket.CloseError],
+// so that it can be reported as a [*WebSocketCloseError].
 type WSConn interface {
 	Close() error
 	WriteMessage(messageType int, data []byte) error
@@ -216,6 +249,17 @@ type Request struct {
 	// require this unless there are multiple queries in the
 	// document, but genqlient sets it unconditionally anyway.
 	OpName string `json:"operationName"`
+	// The hex-encoded SHA-256 hash of Query, which identifies the operation
+	// to servers which support persisted operations.  genqlient sets this in
+	// the generated code, but only custom Clients read it: the clients this
+	// package constructs (NewClient and the like) never send it.  A custom
+	// Client may send it in place of (or along with) the query, for example
+	// as extensions.persistedQuery.sha256Hash, per Apollo's protocol.
+	Hash string `json:"-"`
+	// True if the operation uses @defer or @stream, in which case the client
+	// asks the server for an incremental (multipart) response.  genqlient
+	// sets this in the generated code; it's not sent to the server.
+	Incremental bool `json:"-"`
 }
 
 type BaseResponse[T any] struct {
@@ -236,6 +280,29 @@ type BaseResponse[T any] struct {
 type Response BaseResponse[any]
 
 func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response) error {
+	return c.makeRequest(ctx, req, resp, nil)
+}
+
+func (c *client) MakeIncrementalRequest(
+	ctx context.Context,
+	req *Request,
+	resp *Response,
+	onPayload func(hasNext bool) error,
+) error {
+	if onPayload == nil {
+		onPayload = func(bool) error { return nil }
+	}
+	return c.makeRequest(ctx, req, resp, onPayload)
+}
+
+// makeRequest implements MakeRequest and MakeIncrementalRequest; onPayload is
+// nil for the former.
+func (c *client) makeRequest(
+	ctx context.Context,
+	req *Request,
+	resp *Response,
+	onPayload func(hasNext bool) error,
+) error {
 	var httpReq *http.Request
 	var err error
 	if c.method == http.MethodGet {
@@ -248,6 +315,9 @@ func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response)
 		return err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
+	if req.Incremental {
+		httpReq.Header.Set("Accept", incrementalAccept)
+	}
 
 	if ctx != nil {
 		httpReq = httpReq.WithContext(ctx)
@@ -260,29 +330,18 @@ func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response)
 	defer httpResp.Body.Close()
 
 	if httpResp.StatusCode != http.StatusOK {
-		var respBody []byte
-		respBody, err = io.ReadAll(httpResp.Body)
-		if err != nil {
-			respBody = []byte(fmt.Sprintf("<unreadable: %v>", err))
-		}
-
-		var gqlResp Response
-		if err = json.Unmarshal(respBody, &gqlResp); err != nil {
-			return &HTTPError{
-				Response: Response{
-					Errors: gqlerror.List{&gqlerror.Error{Message: string(respBody)}},
-				},
-				StatusCode: httpResp.StatusCode,
-			}
-		}
+		return httpErrorFromResponse(httpResp)
+	}
 
-		return &HTTPError{
-			Response:   gqlResp,
-			StatusCode: httpResp.StatusCode,
+	mediaType, params, _ := mime.ParseMediaType(httpResp.Header.Get("Content-Type"))
+	if mediaType == "multipart/mixed" {
+		err = readIncrementalResponse(httpResp, params["boundary"], resp, onPayload)
+	} else {
+		err = json.NewDecoder(httpResp.Body).Decode(resp)
+		if err == nil && onPayload != nil {
+			err = onPayload(false)
 		}
 	}
-
-	err = json.NewDecoder(httpResp.Body).Decode(resp)
 	if err != nil {
 		return err
 	}
@@ -292,6 +351,30 @@ func (c *client) MakeRequest(ctx context.Context, req *Request, resp *Response)
 	return nil
 }
 
+// httpErrorFromResponse reads the body of a non-200 response into an
+// [*HTTPError].
+func httpErrorFromResponse(httpResp *http.Response) *HTTPError {
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		respBody = []byte(fmt.Sprintf("<unreadable: %v>", err))
+	}
+
+	var gqlResp Response
+	if err = json.Unmarshal(respBody, &gqlResp); err != nil {
+		return &HTTPError{
+			Response: Response{
+				Errors: gqlerror.List{&gqlerror.Error{Message: string(respBody)}},
+			},
+			StatusCode: httpResp.StatusCode,
+		}
+	}
+
+	return &HTTPError{
+		Response:   gqlResp,
+		StatusCode: httpResp.StatusCode,
+	}
+}
+
 func (c *client) createPostRequest(req *Request) (*http.Request, error) {
 	if req.Query != "" {
 		if strings.HasPrefix(strings.TrimSpace(req.Query), "subscription") {
@@ -2,7 +2,9 @@ package graphql
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 )
 
 // HTTPError represents an HTTP error with status code and response body.
@@ -20,3 +22,129 @@ func (e *HTTPError) Error() string {
 
 	return fmt.Sprintf("returned error %v: %s", e.StatusCode, jsonBody)
 }
+
+// Close codes which may be sent by a server implementing the
+// graphql-transport-ws protocol; see [the protocol specification].
+//
+// [the protocol specification]: https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const (
+	WebSocketCloseBadResponse              = 4004
+	WebSocketCloseInternalClientError      = 4005
+	WebSocketCloseBadRequest               = 4400
+	WebSocketCloseUnauthorized             = 4401
+	WebSocketCloseForbidden                = 4403
+	WebSocketCloseSubprotocolNotAcceptable = 4406
+	WebSocketCloseConnectionInitTimeout    = 4408
+	WebSocketCloseSubscriberAlreadyExists  = 4409
+	WebSocketCloseTooManyInitRequests      = 4429
+	WebSocketCloseInternalServerError      = 4500
+	WebSocketCloseConnectionAckTimeout     = 4504
+)
+
+// WebSocketCloseError is returned (or sent on the error channel returned by
+// [WebSocketClient.Start]) when the server closes the webSocket connection.
+//
+// Code is the close code sent by the server, e.g. one of the
+// WebSocketClose* constants; Reason is the accompanying text, if any.
+type WebSocketCloseError struct {
+	// The error returned by [WSConn.ReadMessage].
+	Err    error
+	Reason string
+	Code   int
+}
+
+// Error implements the error interface for WebSocketCloseError.
+func (e *WebSocketCloseError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("websocket closed by server with code %d", e.Code)
+	}
+	return fmt.Sprintf("websocket closed by server with code %d: %s", e.Code, e.Reason)
+}
+
+func (e *WebSocketCloseError) Unwrap() error {
+	return e.Err
+}
+
+// WebSocketProtocolError is returned (or sent on the error channel returned by
+// [WebSocketClient.Start]) when the server sends a message which violates the
+// webSocket subscription protocol, for example a malformed message or a
+// message for an unknown subscription.
+type WebSocketProtocolError struct {
+	// The underlying error, if any (e.g. a JSON syntax error).
+	Err error
+	// A description of the violation.
+	Reason string
+	// The raw message received from the server, if any.
+	Message []byte
+}
+
+// Error implements the error interface for WebSocketProtocolError.
+func (e *WebSocketProtocolError) Error() string {
+	if e.Err == nil {
+		return "websocket protocol error: " + e.Reason
+	}
+	return fmt.Sprintf("websocket protocol error: %s: %v", e.Reason, e.Err)
+}
+
+func (e *WebSocketProtocolError) Unwrap() error {
+	return e.Err
+}
+
+// MultipartProtocolError is returned (or sent on the error channel returned by
+// [WebSocketClient.Start], for a client created by
+// [NewClientUsingMultipartHTTP]) when the server sends a multipart response
+// which can't be parsed, for example a part which isn't valid JSON.
+type MultipartProtocolError struct {
+	// The underlying error, if any (e.g. a JSON syntax error).
+	Err error
+	// A description of the violation.
+	Reason string
+	// The raw part received from the server, if any.
+	Message []byte
+}
+
+// Error implements the error interface for MultipartProtocolError.
+func (e *MultipartProtocolError) Error() string {
+	if e.Err == nil {
+		return "multipart protocol error: " + e.Reason
+	}
+	return fmt.Sprintf("multipart protocol error: %s: %v", e.Reason, e.Err)
+}
+
+func (e *MultipartProtocolError) Unwrap() error {
+	return e.Err
+}
+
+// asWebSocketCloseError converts an error returned by [WSConn.ReadMessage]
+// into a [*WebSocketCloseError], if it (or an error it wraps) describes a
+// close frame, and returns it unchanged otherwise.
+//
+// Since WSConn is an interface, we can't depend on a particular error type;
+// instead we recognize any struct with integer field Code and string field
+// Text, which includes [github.com/gorilla/websocket]'s *CloseError.
+func asWebSocketCloseError(err error) error {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		v := reflect.ValueOf(e)
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				continue
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		code := v.FieldByName("Code")
+		text := v.FieldByName("Text")
+		if !code.IsValid() || code.Kind() != reflect.Int ||
+			!text.IsValid() || text.Kind() != reflect.String {
+			continue
+		}
+		return &WebSocketCloseError{
+			Code:   int(code.Int()),
+			Reason: text.String(),
+			Err:    err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,341 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// incrementalAccept is the Accept header sent with requests for operations
+// which use @defer or @stream.
+const incrementalAccept = `multipart/mixed;deferSpec=20220824, application/json`
+
+// IncrementalClient is a [Client] which can additionally deliver the
+// response to an operation using @defer or @stream as it arrives, rather
+// than only once it is complete.
+//
+// The clients returned by [NewClient] and [NewClientUsingGet] implement
+// IncrementalClient.  (Their MakeRequest also supports such operations, but
+// returns only the complete response.)
+type IncrementalClient interface {
+	Client
+
+	// MakeIncrementalRequest is like MakeRequest, except that it calls
+	// onPayload each time a payload of the response has been received and
+	// merged into resp.  hasNext is true if more payloads are expected.
+	//
+	// If onPayload returns an error, MakeIncrementalRequest stops reading
+	// the response and returns that error.  Otherwise, as with MakeRequest,
+	// it returns the errors, if any, from all the payloads once the response
+	// is complete.
+	MakeIncrementalRequest(
+		ctx context.Context,
+		req *Request,
+		resp *Response,
+		onPayload func(hasNext bool) error,
+	) error
+}
+
+// incrementalResult accumulates the payloads of an incrementally delivered
+// response.  Both the format of the [incremental delivery RFC] and the older
+// format from 2022 (used by e.g. gqlgen and Apollo) are supported.
+//
+// We merge the payloads as generic JSON, rather than into the generated
+// response types, so that we need not know anything about their structure;
+// the caller then unmarshals the result (see writeTo).
+//
+// [incremental delivery RFC]: https://github.com/graphql/graphql-spec/pull/742
+type incrementalResult struct {
+	// The data so far, as decoded by decodeJSON.
+	data       interface{}
+	extensions map[string]interface{}
+	// The path of each pending @defer or @stream, by ID.
+	pending map[string][]interface{}
+	errors  gqlerror.List
+}
+
+type incrementalPayload struct {
+	Extensions  map[string]interface{} `json:"extensions"`
+	HasNext     *bool                  `json:"hasNext"`
+	Data        json.RawMessage        `json:"data"`
+	Errors      gqlerror.List          `json:"errors"`
+	Pending     []incrementalPending   `json:"pending"`
+	Incremental []*incrementalItem     `json:"incremental"`
+	Completed   []incrementalCompleted `json:"completed"`
+}
+
+type incrementalPending struct {
+	ID   string        `json:"id"`
+	Path []interface{} `json:"path"`
+}
+
+type incrementalItem struct {
+	Extensions map[string]interface{} `json:"extensions"`
+	ID         string                 `json:"id"`
+	Data       json.RawMessage        `json:"data"`
+	Items      json.RawMessage        `json:"items"`
+	Errors     gqlerror.List          `json:"errors"`
+	Path       []interface{}          `json:"path"`
+	SubPath    []interface{}          `json:"subPath"`
+}
+
+type incrementalCompleted struct {
+	ID     string        `json:"id"`
+	Errors gqlerror.List `json:"errors"`
+}
+
+func newIncrementalResult() *incrementalResult {
+	return &incrementalResult{pending: map[string][]interface{}{}}
+}
+
+// decodeJSON decodes the given JSON into generic Go values, preserving
+// numbers exactly (so that re-encoding them doesn't lose precision).
+func decodeJSON(b []byte) (interface{}, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&v)
+	return v, err
+}
+
+// apply merges the given payload into the result, and returns whether more
+// payloads are expected.
+func (r *incrementalResult) apply(body []byte) (hasNext bool, err error) {
+	var payload incrementalPayload
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		return false, fmt.Errorf("malformed incremental payload: %w", err)
+	}
+
+	r.errors = append(r.errors, payload.Errors...)
+	r.addExtensions(payload.Extensions)
+	if len(payload.Data) != 0 {
+		r.data, err = decodeJSON(payload.Data)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for _, pending := range payload.Pending {
+		r.pending[pending.ID] = pending.Path
+	}
+
+	for _, item := range payload.Incremental {
+		r.errors = append(r.errors, item.Errors...)
+		r.addExtensions(item.Extensions)
+
+		path := item.Path
+		if item.ID != "" {
+			pendingPath, ok := r.pending[item.ID]
+			if !ok {
+				return false, fmt.Errorf("incremental payload for unknown ID %q", item.ID)
+			}
+			path = append(append([]interface{}{}, pendingPath...), item.SubPath...)
+		}
+
+		switch {
+		case len(item.Items) != 0:
+			// In the 2022 format, the path is to the first new item, rather
+			// than to the list.
+			if item.ID == "" && len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+			err = r.appendItems(path, item.Items)
+		case len(item.Data) != 0:
+			err = r.mergeData(path, item.Data)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for _, completed := range payload.Completed {
+		r.errors = append(r.errors, completed.Errors...)
+		delete(r.pending, completed.ID)
+	}
+
+	return payload.HasNext != nil && *payload.HasNext, nil
+}
+
+func (r *incrementalResult) addExtensions(extensions map[string]interface{}) {
+	if len(extensions) == 0 {
+		return
+	}
+	if r.extensions == nil {
+		r.extensions = make(map[string]interface{}, len(extensions))
+	}
+	for k, v := range extensions {
+		r.extensions[k] = v
+	}
+}
+
+// lookup returns the value at the given path in the data.
+func (r *incrementalResult) lookup(path []interface{}) (interface{}, error) {
+	value := r.data
+	for i, key := range path {
+		switch key := key.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("incremental payload path %v: %v is not an object", path, path[:i])
+			}
+			value = object[key]
+		case float64:
+			list, ok := value.([]interface{})
+			if !ok || int(key) < 0 || int(key) >= len(list) {
+				return nil, fmt.Errorf("incremental payload path %v: %v has no index %v", path, path[:i], key)
+			}
+			value = list[int(key)]
+		default:
+			return nil, fmt.Errorf("incremental payload path %v: invalid key %v", path, key)
+		}
+	}
+	return value, nil
+}
+
+// mergeData merges the given data into the object at the given path.
+func (r *incrementalResult) mergeData(path []interface{}, data json.RawMessage) error {
+	target, err := r.lookup(path)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		// The parent was null (e.g. due to an error), so there's nothing to
+		// merge into.
+		return nil
+	}
+	object, ok := target.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("incremental payload path %v is not an object", path)
+	}
+	patch, err := decodeJSON(data)
+	if err != nil {
+		return err
+	}
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("incremental payload data for %v is not an object", path)
+	}
+	mergeObjects(object, patchObject)
+	return nil
+}
+
+// mergeObjects deeply merges src into dst.
+func mergeObjects(dst, src map[string]interface{}) {
+	for k, v := range src {
+		dstObject, dstOK := dst[k].(map[string]interface{})
+		srcObject, srcOK := v.(map[string]interface{})
+		if dstOK && srcOK {
+			mergeObjects(dstObject, srcObject)
+		} else {
+			dst[k] = v
+		}
+	}
+}
+
+// appendItems appends the given items to the list at the given path.
+func (r *incrementalResult) appendItems(path []interface{}, items json.RawMessage) error {
+	if len(path) == 0 {
+		return fmt.Errorf("incremental payload has items but no path")
+	}
+	target, err := r.lookup(path)
+	if err != nil {
+		return err
+	}
+	list, ok := target.([]interface{})
+	if !ok && target != nil {
+		return fmt.Errorf("incremental payload path %v is not a list", path)
+	}
+	newItems, err := decodeJSON(items)
+	if err != nil {
+		return err
+	}
+	newList, ok := newItems.([]interface{})
+	if !ok {
+		return fmt.Errorf("incremental payload items for %v are not a list", path)
+	}
+	list = append(list, newList...)
+
+	// Since appending may reallocate, we need to put the list back in its
+	// parent.
+	parent, err := r.lookup(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	switch key := path[len(path)-1].(type) {
+	case string:
+		if object, ok := parent.(map[string]interface{}); ok {
+			object[key] = list
+			return nil
+		}
+	case float64:
+		if parentList, ok := parent.([]interface{}); ok {
+			parentList[int(key)] = list
+			return nil
+		}
+	}
+	return fmt.Errorf("incremental payload path %v is invalid", path)
+}
+
+// readIncrementalResponse reads a multipart/mixed response to an operation
+// using @defer or @stream into resp, calling onPayload (if non-nil) after each
+// payload.
+func readIncrementalResponse(
+	httpResp *http.Response,
+	boundary string,
+	resp *Response,
+	onPayload func(hasNext bool) error,
+) error {
+	result := newIncrementalResult()
+	complete := false
+	err := readMultipartParts(httpResp.Body, boundary, func(body json.RawMessage) (bool, error) {
+		if bytes.Equal(bytes.TrimSpace(body), []byte("{}")) {
+			return false, nil // heartbeat
+		}
+		hasNext, err := result.apply(body)
+		complete = !hasNext
+		if err != nil || onPayload == nil {
+			return complete, err
+		}
+		err = result.writeTo(resp)
+		if err != nil {
+			return true, err
+		}
+		return complete, onPayload(hasNext)
+	})
+	if err != nil {
+		return err
+	}
+	if !complete {
+		// The response ended (e.g. the connection was dropped) before the
+		// final payload; the data is missing whatever was yet to arrive.
+		return &MultipartProtocolError{
+			Reason: "incremental response ended before the final payload (with hasNext: false)",
+		}
+	}
+	if onPayload == nil {
+		return result.writeTo(resp)
+	}
+	return nil
+}
+
+// writeTo unmarshals the result so far into resp.
+func (r *incrementalResult) writeTo(resp *Response) error {
+	data, err := json.Marshal(struct {
+		Data interface{} `json:"data"`
+	}{r.data})
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(data, resp)
+	if err != nil {
+		return err
+	}
+	resp.Errors = r.errors
+	resp.Extensions = r.extensions
+	return nil
+}
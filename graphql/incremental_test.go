@@ -0,0 +1,246 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const incrementalTestQuery = `query { hero { name ... @defer { friends @stream { name } } } }`
+
+func makeIncrementalServer(t *testing.T, parts []string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept"), "multipart/mixed")
+		mw := multipart.NewWriter(w)
+		assert.NoError(t, mw.SetBoundary("-"))
+		w.Header().Set("Content-Type", `multipart/mixed;boundary="-";deferSpec=20220824`)
+		w.WriteHeader(http.StatusOK)
+		for _, part := range parts {
+			pw, err := mw.CreatePart(map[string][]string{"Content-Type": {"application/json"}})
+			assert.NoError(t, err)
+			_, err = pw.Write([]byte(part))
+			assert.NoError(t, err)
+			w.(http.Flusher).Flush()
+		}
+		assert.NoError(t, mw.Close())
+	}))
+}
+
+type incrementalTestHero struct {
+	Friends *[]struct {
+		Name string `json:"name"`
+	} `json:"friends,omitempty"`
+	Name string `json:"name"`
+}
+
+type incrementalTestResponse struct {
+	Hero incrementalTestHero `json:"hero"`
+}
+
+// formats for the same response: the initial payload has the hero's name;
+// the deferred fragment brings the first friend, and the stream the second.
+var incrementalTestFormats = []struct {
+	name  string
+	parts []string
+}{
+	{
+		"2022",
+		[]string{
+			`{"data": {"hero": {"name": "Luke"}}, "hasNext": true}`,
+			`{"incremental": [{"data": {"friends": [{"name": "Han"}]}, "path": ["hero"]}], "hasNext": true}`,
+			`{}`,
+			`{"incremental": [{"items": [{"name": "Leia"}], "path": ["hero", "friends", 1]}], "hasNext": false}`,
+		},
+	},
+	{
+		"RFC",
+		[]string{
+			`{"data": {"hero": {"name": "Luke"}}, "pending": [{"id": "0", "path": ["hero"]}], "hasNext": true}`,
+			`{"incremental": [{"id": "0", "data": {"friends": [{"name": "Han"}]}}], "pending": [{"id": "1", "path": ["hero", "friends"]}], "completed": [{"id": "0"}], "hasNext": true}`,
+			`{"incremental": [{"id": "1", "items": [{"name": "Leia"}]}], "completed": [{"id": "1"}], "hasNext": false}`,
+		},
+	},
+}
+
+func TestMakeRequestIncremental(t *testing.T) {
+	for _, format := range incrementalTestFormats {
+		t.Run(format.name, func(t *testing.T) {
+			server := makeIncrementalServer(t, format.parts)
+			defer server.Close()
+
+			var data incrementalTestResponse
+			err := NewClient(server.URL, server.Client()).MakeRequest(
+				context.Background(),
+				&Request{Query: incrementalTestQuery, Incremental: true},
+				&Response{Data: &data})
+			require.NoError(t, err)
+
+			assert.Equal(t, "Luke", data.Hero.Name)
+			require.NotNil(t, data.Hero.Friends)
+			require.Len(t, *data.Hero.Friends, 2)
+			assert.Equal(t, "Han", (*data.Hero.Friends)[0].Name)
+			assert.Equal(t, "Leia", (*data.Hero.Friends)[1].Name)
+		})
+	}
+}
+
+func TestMakeIncrementalRequest(t *testing.T) {
+	for _, format := range incrementalTestFormats {
+		t.Run(format.name, func(t *testing.T) {
+			server := makeIncrementalServer(t, format.parts)
+			defer server.Close()
+
+			var data incrementalTestResponse
+			var snapshots []string
+			var hasNexts []bool
+			client := NewClient(server.URL, server.Client()).(IncrementalClient)
+			err := client.MakeIncrementalRequest(
+				context.Background(),
+				&Request{Query: incrementalTestQuery, Incremental: true},
+				&Response{Data: &data},
+				func(hasNext bool) error {
+					b, err := json.Marshal(data)
+					require.NoError(t, err)
+					snapshots = append(snapshots, string(b))
+					hasNexts = append(hasNexts, hasNext)
+					return nil
+				})
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{
+				`{"hero":{"name":"Luke"}}`,
+				`{"hero":{"friends":[{"name":"Han"}],"name":"Luke"}}`,
+				`{"hero":{"friends":[{"name":"Han"},{"name":"Leia"}],"name":"Luke"}}`,
+			}, snapshots)
+			assert.Equal(t, []bool{true, true, false}, hasNexts)
+		})
+	}
+}
+
+func TestMakeIncrementalRequestErrors(t *testing.T) {
+	t.Run("PayloadErrors", func(t *testing.T) {
+		server := makeIncrementalServer(t, []string{
+			`{"data": {"hero": {"name": "Luke"}}, "hasNext": true}`,
+			`{"incremental": [{"data": {"friends": null}, "path": ["hero"], "errors": [{"message": "no friends"}]}], "hasNext": false}`,
+		})
+		defer server.Close()
+
+		var data incrementalTestResponse
+		resp := &Response{Data: &data}
+		err := NewClient(server.URL, server.Client()).MakeRequest(
+			context.Background(), &Request{Query: incrementalTestQuery, Incremental: true}, resp)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "no friends")
+		assert.Equal(t, "Luke", data.Hero.Name)
+		assert.Nil(t, data.Hero.Friends)
+	})
+
+	t.Run("CallbackError", func(t *testing.T) {
+		server := makeIncrementalServer(t, incrementalTestFormats[0].parts)
+		defer server.Close()
+
+		calls := 0
+		stop := errors.New("stop")
+		client := NewClient(server.URL, server.Client()).(IncrementalClient)
+		err := client.MakeIncrementalRequest(
+			context.Background(),
+			&Request{Query: incrementalTestQuery, Incremental: true},
+			&Response{Data: &incrementalTestResponse{}},
+			func(hasNext bool) error {
+				calls++
+				return stop
+			})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		// The connection is closed before the final payload.
+		server := makeIncrementalServer(t, incrementalTestFormats[0].parts[:2])
+		defer server.Close()
+
+		var data incrementalTestResponse
+		err := NewClient(server.URL, server.Client()).MakeRequest(
+			context.Background(),
+			&Request{Query: incrementalTestQuery, Incremental: true},
+			&Response{Data: &data})
+		var protocolErr *MultipartProtocolError
+		assert.ErrorAs(t, err, &protocolErr)
+
+		var hasNexts []bool
+		client := NewClient(server.URL, server.Client()).(IncrementalClient)
+		err = client.MakeIncrementalRequest(
+			context.Background(),
+			&Request{Query: incrementalTestQuery, Incremental: true},
+			&Response{Data: &incrementalTestResponse{}},
+			func(hasNext bool) error {
+				hasNexts = append(hasNexts, hasNext)
+				return nil
+			})
+		assert.ErrorAs(t, err, &protocolErr)
+		assert.Equal(t, []bool{true, true}, hasNexts)
+	})
+
+	t.Run("InvalidPath", func(t *testing.T) {
+		server := makeIncrementalServer(t, []string{
+			`{"data": {"hero": {"name": "Luke"}}, "hasNext": true}`,
+			`{"incremental": [{"data": {"name": "Han"}, "path": ["hero", "name", 0]}], "hasNext": false}`,
+		})
+		defer server.Close()
+
+		err := NewClient(server.URL, server.Client()).MakeRequest(
+			context.Background(),
+			&Request{Query: incrementalTestQuery, Incremental: true},
+			&Response{Data: &incrementalTestResponse{}})
+		assert.ErrorContains(t, err, "has no index 0")
+	})
+}
+
+func TestMakeIncrementalRequestNotMultipart(t *testing.T) {
+	// A server which doesn't support incremental delivery just sends the
+	// complete response.
+	server := makeServer(t, http.StatusOK, map[string]any{
+		"data": map[string]any{"hero": map[string]any{"name": "Luke"}},
+	})
+	defer server.Close()
+
+	var data incrementalTestResponse
+	var hasNexts []bool
+	client := NewClient(server.URL, server.Client()).(IncrementalClient)
+	err := client.MakeIncrementalRequest(
+		context.Background(),
+		&Request{Query: incrementalTestQuery, Incremental: true},
+		&Response{Data: &data},
+		func(hasNext bool) error {
+			hasNexts = append(hasNexts, hasNext)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "Luke", data.Hero.Name)
+	assert.Equal(t, []bool{false}, hasNexts)
+}
+
+func TestMakeRequestNotIncremental(t *testing.T) {
+	// Only requests marked Incremental ask for a multipart response, even if
+	// the query text mentions @defer.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotContains(t, r.Header.Get("Accept"), "multipart/mixed")
+		_, _ = w.Write([]byte(`{"data": {"hero": {"name": "Luke"}}}`))
+	}))
+	defer server.Close()
+
+	var data incrementalTestResponse
+	err := NewClient(server.URL, server.Client()).MakeRequest(
+		context.Background(),
+		&Request{Query: `query { hero(search: "@defer") { name } }`},
+		&Response{Data: &data})
+	require.NoError(t, err)
+	assert.Equal(t, "Luke", data.Hero.Name)
+}
@@ -0,0 +1,384 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// multipartSubscriptionAccept is the Accept header sent with multipart
+// subscription requests; see [the Apollo multipart subscription protocol].
+//
+// [the Apollo multipart subscription protocol]: https://www.apollographql.com/docs/graphos/routing/operations/subscriptions/multipart-protocol
+const multipartSubscriptionAccept = `multipart/mixed;subscriptionSpec="1.0", application/json`
+
+// multipartClient is the [WebSocketClient] returned by
+// [NewClientUsingMultipartHTTP].
+//
+// Each subscription is a separate HTTP request, whose response body is read by
+// its own goroutine; that goroutine owns the subscription's interfaceChan,
+// and closes it when the response ends or the subscription is unsubscribed.
+type multipartClient struct {
+	httpClient Doer
+	// Errors from all subscriptions are sent here, if Start was called.
+	errChan chan error
+	// The parent of each subscription's request context; canceled by Close,
+	// to abort all requests and stop any goroutines sending errors.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Cancels the request for each active subscription, by ID.
+	cancels  map[string]context.CancelFunc
+	endpoint string
+	// Set by WithMultipartTimeout.
+	timeout time.Duration
+
+	// Hold for reading when sending to errChan, and for writing when closing
+	// it.
+	errChanMu sync.RWMutex
+
+	// Hold when accessing cancels, started, and closed.
+	mu      sync.Mutex
+	started bool
+	closed  bool
+}
+
+// NewClientUsingMultipartHTTP returns a [WebSocketClient] which makes
+// subscription requests to the given endpoint using multipart HTTP responses,
+// as served by gateways such as Apollo Router, rather than webSocket.  (It
+// implements WebSocketClient so that it may be passed to genqlient's
+// generated subscription functions.)  It will use the given [http.Client],
+// or [http.DefaultClient] if a nil client is passed.
+//
+// Each subscription makes a POST request, and reads its payloads from the
+// parts of the multipart/mixed response; both the [Apollo multipart
+// subscription protocol] and the GraphQL-over-HTTP incremental delivery
+// format are supported.
+//
+// Calling Start is optional: it does not connect, but returns a channel on
+// which errors such as network failures will be sent. Either way, such an
+// error ends the affected subscription, closing its channel.
+//
+// Close cancels all requests, including any still waiting for the server to
+// respond.  To bound how long each request may wait, or tie the requests to a
+// context, pass [WithMultipartTimeout] or [WithMultipartContext].
+//
+// The client does not support queries nor mutations, and will return an error
+// if passed a request that attempts one.
+//
+// [Apollo multipart subscription protocol]: https://www.apollographql.com/docs/graphos/routing/operations/subscriptions/multipart-protocol
+func NewClientUsingMultipartHTTP(endpoint string, httpClient Doer, opts ...MultipartOption) WebSocketClient {
+	if httpClient == nil || httpClient == (*http.Client)(nil) {
+		httpClient = http.DefaultClient
+	}
+	client := &multipartClient{
+		httpClient: httpClient,
+		errChan:    make(chan error),
+		ctx:        context.Background(),
+		cancels:    make(map[string]context.CancelFunc),
+		endpoint:   endpoint,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	client.ctx, client.cancel = context.WithCancel(client.ctx)
+	return client
+}
+
+// MultipartOption configures a client created by [NewClientUsingMultipartHTTP].
+type MultipartOption func(*multipartClient)
+
+// WithMultipartContext makes each subscription request with a context derived
+// from ctx, so that canceling ctx aborts any request still waiting for the
+// server to respond, and ends all subscriptions.
+func WithMultipartContext(ctx context.Context) MultipartOption {
+	return func(c *multipartClient) {
+		c.ctx = ctx
+	}
+}
+
+// WithMultipartTimeout limits how long Subscribe waits for the server to
+// respond to each subscription request; if it takes longer, the request is
+// canceled and Subscribe returns an error.  Once the server has responded,
+// the subscription may last indefinitely.
+func WithMultipartTimeout(timeout time.Duration) MultipartOption {
+	return func(c *multipartClient) {
+		c.timeout = timeout
+	}
+}
+
+func (c *multipartClient) Start(ctx context.Context) (errChan chan error, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("client is closed")
+	}
+	c.started = true
+	return c.errChan, nil
+}
+
+func (c *multipartClient) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	for _, cancel := range c.cancels {
+		cancel()
+	}
+	c.cancels = nil
+	c.mu.Unlock()
+
+	c.cancel()
+	c.errChanMu.Lock()
+	close(c.errChan)
+	c.errChanMu.Unlock()
+	return nil
+}
+
+func (c *multipartClient) Subscribe(req *Request, interfaceChan interface{}, forwardDataFunc ForwardDataFunction) (string, error) {
+	err := checkSubscriptionRequest(req)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", multipartSubscriptionAccept)
+
+	// The timeout only applies until the server responds, so we can't use
+	// context.WithTimeout, which would also end the subscription.
+	var timer *time.Timer
+	if c.timeout > 0 {
+		timer = time.AfterFunc(c.timeout, cancel)
+	}
+	httpResp, err := c.httpClient.Do(httpReq)
+	if timer != nil && !timer.Stop() {
+		// The timer fired, so the request is (or is about to be) canceled.
+		if err == nil {
+			httpResp.Body.Close()
+		}
+		cancel()
+		return "", fmt.Errorf("subscription request timed out after %v", c.timeout)
+	}
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		defer httpResp.Body.Close()
+		cancel()
+		return "", httpErrorFromResponse(httpResp)
+	}
+
+	subscriptionID := uuid.NewString()
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		httpResp.Body.Close()
+		cancel()
+		return "", errors.New("client is closed")
+	}
+	c.cancels[subscriptionID] = cancel
+	c.mu.Unlock()
+
+	go c.readResponse(ctx, subscriptionID, httpResp, interfaceChan, forwardDataFunc)
+	return subscriptionID, nil
+}
+
+func (c *multipartClient) Unsubscribe(subscriptionID string) error {
+	c.mu.Lock()
+	cancel, ok := c.cancels[subscriptionID]
+	delete(c.cancels, subscriptionID)
+	c.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("tried to unsubscribe from unknown subscription with ID '%s'", subscriptionID)
+	}
+	// This causes readResponse to stop, and close the channel.
+	cancel()
+	return nil
+}
+
+// readResponse forwards the payloads of httpResp until it ends, or ctx is
+// canceled, then ends the subscription.
+func (c *multipartClient) readResponse(
+	ctx context.Context,
+	subscriptionID string,
+	httpResp *http.Response,
+	interfaceChan interface{},
+	forwardDataFunc ForwardDataFunction,
+) {
+	err := forwardMultipartResponse(httpResp, interfaceChan, forwardDataFunc)
+	// If the context was canceled, any error is just the result of our
+	// unsubscribing.
+	unsubscribed := ctx.Err() != nil
+	httpResp.Body.Close()
+	reflect.ValueOf(interfaceChan).Close()
+
+	c.mu.Lock()
+	if cancel, ok := c.cancels[subscriptionID]; ok {
+		cancel()
+		delete(c.cancels, subscriptionID)
+	}
+	started := c.started
+	c.mu.Unlock()
+
+	if err == nil || unsubscribed || !started {
+		return
+	}
+	c.sendError(err)
+}
+
+// sendError sends err to c.errChan, unless the client is closed first.
+func (c *multipartClient) sendError(err error) {
+	c.errChanMu.RLock()
+	defer c.errChanMu.RUnlock()
+	select {
+	case <-c.ctx.Done():
+		return // errChan may be closed
+	default:
+	}
+	select {
+	case c.errChan <- err:
+	case <-c.ctx.Done():
+	}
+}
+
+// forwardMultipartResponse forwards the payloads of httpResp to
+// forwardDataFunc, returning once the response ends.
+func forwardMultipartResponse(httpResp *http.Response, interfaceChan interface{}, forwardDataFunc ForwardDataFunction) error {
+	mediaType, params, err := mime.ParseMediaType(httpResp.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		// Not multipart; the server may have sent a single response (e.g. an
+		// error), which we forward as is.
+		body, err := io.ReadAll(httpResp.Body)
+		if err != nil {
+			return err
+		}
+		_, err = forwardMultipartPart(body, interfaceChan, forwardDataFunc)
+		return err
+	}
+
+	return readMultipartParts(httpResp.Body, params["boundary"], func(body json.RawMessage) (bool, error) {
+		return forwardMultipartPart(body, interfaceChan, forwardDataFunc)
+	})
+}
+
+// readMultipartParts calls handlePart with the JSON body of each part of the
+// given multipart/mixed response body, until it returns true or an error, or
+// the response ends.
+func readMultipartParts(body io.Reader, boundary string, handlePart func(body json.RawMessage) (done bool, err error)) error {
+	if boundary == "" {
+		boundary = "-" // the default per the incremental delivery spec
+	}
+	reader := multipart.NewReader(body, boundary)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		// Rather than reading the part to EOF, we decode a single JSON value
+		// from it: the server may not send the next boundary (which is what
+		// ends the part) until it has another payload to send.
+		var partBody json.RawMessage
+		err = json.NewDecoder(part).Decode(&partBody)
+		if errors.Is(err, io.EOF) {
+			continue // empty part
+		} else if err != nil {
+			return &MultipartProtocolError{
+				Reason: "received malformed multipart payload",
+				Err:    err,
+			}
+		}
+		done, err := handlePart(partBody)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// forwardMultipartPart forwards the payload, if any, of a single part of a
+// multipart response, and returns whether it was the last part.
+//
+// Parts may be either Apollo-style, i.e. {"payload": {...}} for data, {} for
+// heartbeats, or {"errors": [...]} for transport-level errors, or
+// incremental-delivery-style, i.e. a GraphQL response, optionally with
+// "hasNext".
+func forwardMultipartPart(body []byte, interfaceChan interface{}, forwardDataFunc ForwardDataFunction) (done bool, err error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return false, nil
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(body, &fields)
+	if err != nil {
+		return false, &MultipartProtocolError{
+			Reason:  "received malformed multipart payload",
+			Message: body,
+			Err:     err,
+		}
+	}
+
+	payload, isApolloPayload := fields["payload"]
+	errs, hasErrors := fields["errors"]
+	switch {
+	case len(fields) == 0: // heartbeat
+		return false, nil
+	case isApolloPayload:
+		if !bytes.Equal(payload, []byte("null")) {
+			err = forwardDataFunc(interfaceChan, payload)
+			if err != nil {
+				return false, err
+			}
+		}
+		if !hasErrors {
+			return false, nil
+		}
+	case hasErrors && len(fields) == 1:
+		// transport-level errors; handled below
+	default:
+		var incremental struct {
+			HasNext *bool `json:"hasNext"`
+		}
+		err = json.Unmarshal(body, &incremental)
+		if err != nil {
+			return false, err
+		}
+		err = forwardDataFunc(interfaceChan, body)
+		return incremental.HasNext != nil && !*incremental.HasNext, err
+	}
+
+	// Transport-level errors terminate the subscription; we forward them as
+	// its final response.
+	errPayload, err := errorMessagePayload(errs)
+	if err != nil {
+		return true, &MultipartProtocolError{
+			Reason:  "received malformed multipart errors",
+			Message: body,
+			Err:     err,
+		}
+	}
+	return true, forwardDataFunc(interfaceChan, errPayload)
+}
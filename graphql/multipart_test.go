@@ -0,0 +1,281 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeMultipartServer returns a server which responds to each request with
+// the given parts, in a multipart/mixed response, and then, if block is set,
+// waits for the client to go away.
+func makeMultipartServer(t *testing.T, parts []string, block bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept"), "multipart/mixed")
+		var req Request
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "subscription { count }", req.Query)
+
+		mw := multipart.NewWriter(w)
+		assert.NoError(t, mw.SetBoundary("graphql"))
+		w.Header().Set("Content-Type", `multipart/mixed;boundary="graphql";subscriptionSpec="1.0"`)
+		w.WriteHeader(http.StatusOK)
+		for _, part := range parts {
+			pw, err := mw.CreatePart(map[string][]string{"Content-Type": {"application/json"}})
+			assert.NoError(t, err)
+			_, err = pw.Write([]byte(part))
+			assert.NoError(t, err)
+			w.(http.Flusher).Flush()
+		}
+		if block {
+			<-r.Context().Done()
+			return
+		}
+		assert.NoError(t, mw.Close())
+	}))
+}
+
+// collectResponses subscribes using c, and returns all responses received
+// until the channel is closed.
+func collectResponses(t *testing.T, c WebSocketClient) []Response {
+	dataChan := make(chan Response)
+	_, err := c.Subscribe(
+		&Request{Query: "subscription { count }"},
+		dataChan,
+		func(interfaceChan any, jsonRawMsg json.RawMessage) error {
+			var resp Response
+			if err := json.Unmarshal(jsonRawMsg, &resp); err != nil {
+				return err
+			}
+			interfaceChan.(chan Response) <- resp
+			return nil
+		})
+	require.NoError(t, err)
+
+	var resps []Response
+	for {
+		select {
+		case resp, ok := <-dataChan:
+			if !ok {
+				return resps
+			}
+			resps = append(resps, resp)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for subscription to end")
+		}
+	}
+}
+
+func TestMultipartSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		parts      []string
+		wantData   []any
+		wantErrors []string
+	}{
+		{
+			name: "apollo payloads and heartbeats",
+			parts: []string{
+				`{}`,
+				`{"payload":{"data":{"count":0}}}`,
+				`{}`,
+				`{"payload":{"data":{"count":1}}}`,
+			},
+			wantData: []any{
+				map[string]any{"count": float64(0)},
+				map[string]any{"count": float64(1)},
+			},
+		},
+		{
+			name: "apollo payload with errors",
+			parts: []string{
+				`{"payload":{"data":null,"errors":[{"message":"field error"}]}}`,
+				`{"payload":{"data":{"count":1}}}`,
+			},
+			wantData: []any{nil, map[string]any{"count": float64(1)}},
+			wantErrors: []string{
+				"input: field error\n",
+				"",
+			},
+		},
+		{
+			name: "apollo transport error ends subscription",
+			parts: []string{
+				`{"payload":{"data":{"count":0}}}`,
+				`{"payload":null,"errors":[{"message":"router went away"}]}`,
+				`{"payload":{"data":{"count":1}}}`,
+			},
+			wantData:   []any{map[string]any{"count": float64(0)}, nil},
+			wantErrors: []string{"", "input: router went away\n"},
+		},
+		{
+			name: "incremental delivery",
+			parts: []string{
+				`{"data":{"count":0},"hasNext":true}`,
+				`{"data":{"count":1},"hasNext":false}`,
+				`{"data":{"count":2},"hasNext":true}`,
+			},
+			wantData: []any{
+				map[string]any{"count": float64(0)},
+				map[string]any{"count": float64(1)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := makeMultipartServer(t, tt.parts, false)
+			defer server.Close()
+			c := NewClientUsingMultipartHTTP(server.URL, server.Client())
+			defer c.Close()
+
+			resps := collectResponses(t, c)
+			require.Len(t, resps, len(tt.wantData))
+			for i, resp := range resps {
+				assert.Equal(t, tt.wantData[i], resp.Data)
+				if len(tt.wantErrors) <= i || tt.wantErrors[i] == "" {
+					assert.Empty(t, resp.Errors)
+				} else {
+					assert.EqualError(t, resp.Errors, tt.wantErrors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMultipartSubscriptionUnsubscribe(t *testing.T) {
+	server := makeMultipartServer(t, []string{`{"payload":{"data":{"count":0}}}`}, true)
+	defer server.Close()
+	c := NewClientUsingMultipartHTTP(server.URL, server.Client())
+	errChan, err := c.Start(context.Background())
+	require.NoError(t, err)
+
+	dataChan := make(chan json.RawMessage)
+	id, err := c.Subscribe(
+		&Request{Query: "subscription { count }"},
+		dataChan,
+		func(interfaceChan any, jsonRawMsg json.RawMessage) error {
+			interfaceChan.(chan json.RawMessage) <- jsonRawMsg
+			return nil
+		})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"count":0}}`, string(<-dataChan))
+
+	require.NoError(t, c.Unsubscribe(id))
+	select {
+	case _, ok := <-dataChan:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for subscription channel to close")
+	}
+	assert.Error(t, c.Unsubscribe(id))
+
+	require.NoError(t, c.Close())
+	_, ok := <-errChan
+	assert.False(t, ok, "errChan not closed")
+}
+
+func TestMultipartSubscriptionErrors(t *testing.T) {
+	t.Run("http error", func(t *testing.T) {
+		server := makeServer(t, http.StatusUnauthorized, Response{})
+		defer server.Close()
+		c := NewClientUsingMultipartHTTP(server.URL, server.Client())
+		defer c.Close()
+
+		_, err := c.Subscribe(&Request{Query: "subscription { count }"}, make(chan any), nil)
+		var httpErr *HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		c := NewClientUsingMultipartHTTP("http://example.com", nil)
+		_, err := c.Subscribe(&Request{Query: "query { count }"}, make(chan any), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("malformed part", func(t *testing.T) {
+		server := makeMultipartServer(t, []string{`not json`}, false)
+		defer server.Close()
+		c := NewClientUsingMultipartHTTP(server.URL, server.Client())
+		errChan, err := c.Start(context.Background())
+		require.NoError(t, err)
+		defer c.Close()
+
+		resps := collectResponses(t, c)
+		assert.Empty(t, resps)
+		select {
+		case err := <-errChan:
+			var protocolErr *MultipartProtocolError
+			assert.True(t, errors.As(err, &protocolErr))
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for error")
+		}
+	})
+}
+
+// TestMultipartSubscriptionStalled tests the ways to give up on a server which
+// never responds to the subscription request.
+func TestMultipartSubscriptionStalled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// (The server only notices the client going away once we've read
+		// the body.)
+		_, _ = io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	subscribe := func(c WebSocketClient) chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := c.Subscribe(&Request{Query: "subscription { count }"}, make(chan any), nil)
+			errChan <- err
+		}()
+		return errChan
+	}
+	waitForError := func(t *testing.T, errChan chan error) error {
+		select {
+		case err := <-errChan:
+			require.Error(t, err)
+			return err
+		case <-time.After(5 * time.Second):
+			t.Fatal("Subscribe didn't return")
+			return nil
+		}
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		c := NewClientUsingMultipartHTTP(server.URL, server.Client(),
+			WithMultipartTimeout(10*time.Millisecond))
+		defer c.Close()
+		err := waitForError(t, subscribe(c))
+		assert.Contains(t, err.Error(), "timed out")
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		c := NewClientUsingMultipartHTTP(server.URL, server.Client(),
+			WithMultipartContext(ctx))
+		defer c.Close()
+		errChan := subscribe(c)
+		cancel()
+		err := waitForError(t, errChan)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		c := NewClientUsingMultipartHTTP(server.URL, server.Client())
+		errChan := subscribe(c)
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, c.Close())
+		waitForError(t, errChan)
+	})
+}
@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Omittable is an optional GraphQL value, which may be unset, explicitly
+// null, or set to a value of type T.  genqlient uses it for nullable fields
+// and arguments when configured with `optional: omittable` in genqlient.yaml.
+//
+// The distinction between unset and null matters mainly for inputs: by
+// GraphQL convention, an update mutation leaves a field unchanged if its
+// input is omitted, but clears it if its input is null.  genqlient omits
+// unset fields entirely when marshaling an input object, and sends null for
+// fields set with [OmittableNull].
+//
+// The zero value is unset; use [OmittableOf] and [OmittableNull] to create
+// set values.  In responses, a field is unset if it was absent from the
+// response (for example because of @skip or @include), and null if it was
+// null.
+type Omittable[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// OmittableOf returns an Omittable set to the given value.
+func OmittableOf[T any](value T) Omittable[T] {
+	return Omittable[T]{value: value, set: true}
+}
+
+// OmittableNull returns an Omittable set to null.
+func OmittableNull[T any]() Omittable[T] {
+	return Omittable[T]{set: true, null: true}
+}
+
+// IsSet returns true if the value is set, either to null or to a value.
+func (o Omittable[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value is set to null.
+func (o Omittable[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// Get returns the value, and true if it is set to a value (rather than being
+// unset or null).
+func (o Omittable[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// Value returns the value, or the zero value of T if it is unset or null.
+func (o Omittable[T]) Value() T {
+	return o.value
+}
+
+// MarshalJSON marshals the value, or null if it is unset or null.  (To omit
+// unset values entirely, the containing object must skip them; genqlient's
+// generated code does so.)
+func (o Omittable[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.null {
+		return []byte("null"), nil
+	}
+	// Marshal via a pointer, so as to use T's MarshalJSON even if it has a
+	// pointer receiver, as genqlient's generated types do.
+	return json.Marshal(&o.value)
+}
+
+// UnmarshalJSON sets the value from the given JSON, which may be null.
+func (o *Omittable[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*o = Omittable[T]{set: true, null: true}
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	*o = OmittableOf(value)
+	return nil
+}
@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pointerMarshaler has a MarshalJSON method with a pointer receiver, like
+// genqlient's generated types.
+type pointerMarshaler struct{ s string }
+
+func (p *pointerMarshaler) MarshalJSON() ([]byte, error) {
+	return json.Marshal("custom " + p.s)
+}
+
+func TestOmittable(t *testing.T) {
+	var unset Omittable[string]
+	assert.False(t, unset.IsSet())
+	assert.False(t, unset.IsNull())
+	_, ok := unset.Get()
+	assert.False(t, ok)
+
+	null := OmittableNull[string]()
+	assert.True(t, null.IsSet())
+	assert.True(t, null.IsNull())
+	_, ok = null.Get()
+	assert.False(t, ok)
+
+	value := OmittableOf("hello")
+	assert.True(t, value.IsSet())
+	assert.False(t, value.IsNull())
+	v, ok := value.Get()
+	assert.True(t, ok)
+	assert.Equal(t, "hello", v)
+	assert.Equal(t, "hello", value.Value())
+}
+
+func TestOmittableMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"Unset", Omittable[string]{}, `null`},
+		{"Null", OmittableNull[string](), `null`},
+		{"Value", OmittableOf("hello"), `"hello"`},
+		{"ZeroValue", OmittableOf(""), `""`},
+		{"List", OmittableOf([]int{1, 2}), `[1,2]`},
+		{"NilList", OmittableOf([]int(nil)), `null`},
+		{"PointerReceiver", OmittableOf(pointerMarshaler{"x"}), `"custom x"`},
+		{"InList", []Omittable[int]{OmittableOf(1), OmittableNull[int]()}, `[1,null]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.value)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+		})
+	}
+}
+
+func TestOmittableUnmarshalJSON(t *testing.T) {
+	var resp struct {
+		Missing Omittable[string] `json:"missing"`
+		Null    Omittable[string] `json:"null"`
+		Value   Omittable[string] `json:"value"`
+		List    Omittable[[]int]  `json:"list"`
+	}
+	err := json.Unmarshal([]byte(`{"null": null, "value": "hello", "list": [1, 2]}`), &resp)
+	require.NoError(t, err)
+
+	assert.False(t, resp.Missing.IsSet())
+	assert.True(t, resp.Null.IsNull())
+	assert.Equal(t, OmittableOf("hello"), resp.Value)
+	assert.Equal(t, OmittableOf([]int{1, 2}), resp.List)
+
+	err = json.Unmarshal([]byte(`{"value": 1}`), &resp)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+// Package scalars contains marshalers and unmarshalers for custom scalars
+// many GraphQL schemas define, such as DateTime, Date, and BigInt.
+//
+// They have the signatures genqlient expects of the marshaler and
+// unmarshaler options in genqlient.yaml's bindings, and are used by the
+// bindings configured by the default_bindings option.  For example:
+//
+//	bindings:
+//	  Date:
+//	    type: time.Time
+//	    marshaler: github.com/Khan/genqlient/graphql/scalars.MarshalDate
+//	    unmarshaler: github.com/Khan/genqlient/graphql/scalars.UnmarshalDate
+package scalars
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const dateFormat = "2006-01-02"
+
+// dateTimeFormats are the formats UnmarshalDateTime accepts, in order.
+// RFC3339Nano also accepts RFC3339 without fractional seconds; the others
+// are variants servers commonly send instead.
+var dateTimeFormats = []string{
+	time.RFC3339Nano,
+	// ISO 8601 with a space instead of a T, as PostgreSQL formats it.
+	"2006-01-02 15:04:05.999999999Z07:00",
+	// No offset; we assume UTC.
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+}
+
+func isNull(b []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(b), []byte("null"))
+}
+
+// MarshalDateTime marshals a time.Time as an RFC3339 string, with as many
+// digits of fractional seconds as needed.  (Like encoding/json, it marshals
+// the zero time as "0001-01-01T00:00:00Z", not null; to send null, use a
+// pointer or the optional option.)
+func MarshalDateTime(t *time.Time) ([]byte, error) {
+	if t == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// UnmarshalDateTime unmarshals a time.Time from an RFC3339 string, or
+// several common variants: with a space instead of the T, or without an
+// offset (in which case the time is taken to be in UTC).  Null unmarshals to
+// the zero time.
+func UnmarshalDateTime(b []byte, t *time.Time) error {
+	if isNull(b) {
+		*t = time.Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid DateTime: must be a string: %w", err)
+	}
+	for _, format := range dateTimeFormats {
+		parsed, err := time.Parse(format, s)
+		if err == nil {
+			*t = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid DateTime %q: expected an RFC3339 date-time", s)
+}
+
+// MarshalDate marshals a time.Time as a date in the format YYYY-MM-DD (RFC3339
+// full-date).  The time of day and location are ignored.  As with
+// MarshalDateTime, the zero time marshals as "0001-01-01", not null.
+func MarshalDate(t *time.Time) ([]byte, error) {
+	if t == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(dateFormat))
+}
+
+// UnmarshalDate unmarshals a time.Time from a date in the format YYYY-MM-DD
+// (RFC3339 full-date), as midnight UTC on that date.  Null unmarshals to the
+// zero time.
+func UnmarshalDate(b []byte, t *time.Time) error {
+	if isNull(b) {
+		*t = time.Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid Date: must be a string: %w", err)
+	}
+	parsed, err := time.Parse(dateFormat, s)
+	if err != nil {
+		return fmt.Errorf("invalid Date %q: expected YYYY-MM-DD", s)
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalBigInt marshals a big.Int as a JSON number, or null if it is nil.
+func MarshalBigInt(i *big.Int) ([]byte, error) {
+	if i == nil {
+		return []byte("null"), nil
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBigInt unmarshals a big.Int from a JSON number or a string
+// containing one: servers commonly send large integers as strings, since
+// JavaScript can't represent them exactly as numbers.  Null unmarshals to
+// zero.
+func UnmarshalBigInt(b []byte, i *big.Int) error {
+	if isNull(b) {
+		i.SetInt64(0)
+		return nil
+	}
+	text := bytes.TrimSpace(b)
+	if len(text) > 0 && text[0] == '"' {
+		var s string
+		if err := json.Unmarshal(text, &s); err != nil {
+			return err
+		}
+		text = []byte(s)
+	}
+	if _, ok := i.SetString(string(text), 10); !ok {
+		return fmt.Errorf("invalid BigInt %s: expected an integer", b)
+	}
+	return nil
+}
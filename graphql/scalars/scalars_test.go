@@ -0,0 +1,148 @@
+package scalars
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTime(t *testing.T) {
+	offset := time.FixedZone("", -7*60*60)
+	tests := []struct {
+		expected time.Time
+		name     string
+		json     string
+	}{
+		{time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), "RFC3339", `"2021-03-04T05:06:07Z"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 0, offset), "Offset", `"2021-03-04T05:06:07-07:00"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 123456789, time.UTC), "Nano", `"2021-03-04T05:06:07.123456789Z"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC), "Millis", `"2021-03-04T05:06:07.123Z"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 0, offset), "Space", `"2021-03-04 05:06:07-07:00"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), "NoOffset", `"2021-03-04T05:06:07"`},
+		{time.Date(2021, 3, 4, 5, 6, 7, 500000000, time.UTC), "SpaceNoOffset", `"2021-03-04 05:06:07.5"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var parsed time.Time
+			err := UnmarshalDateTime([]byte(test.json), &parsed)
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equal(parsed),
+				"expected %v, got %v", test.expected, parsed)
+
+			// Round-tripping gives the same time (though maybe not the same
+			// format).
+			b, err := MarshalDateTime(&parsed)
+			require.NoError(t, err)
+			var roundTripped time.Time
+			err = UnmarshalDateTime(b, &roundTripped)
+			require.NoError(t, err)
+			assert.True(t, parsed.Equal(roundTripped),
+				"expected %v, got %v", parsed, roundTripped)
+		})
+	}
+
+	b, err := MarshalDateTime(&tests[2].expected)
+	require.NoError(t, err)
+	assert.Equal(t, `"2021-03-04T05:06:07.123456789Z"`, string(b))
+
+	var zero time.Time
+	b, err = MarshalDateTime(&zero)
+	require.NoError(t, err)
+	assert.Equal(t, `"0001-01-01T00:00:00Z"`, string(b))
+	parsed := time.Now()
+	err = UnmarshalDateTime(b, &parsed)
+	require.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+
+	b, err = MarshalDateTime(nil)
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(b))
+
+	parsed = time.Now()
+	err = UnmarshalDateTime([]byte(`null`), &parsed)
+	require.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+
+	for _, invalid := range []string{`"2021-03-04"`, `"yesterday"`, `1614834367`} {
+		err = UnmarshalDateTime([]byte(invalid), &parsed)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestDate(t *testing.T) {
+	var parsed time.Time
+	err := UnmarshalDate([]byte(`"2021-03-04"`), &parsed)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), parsed)
+
+	b, err := MarshalDate(&parsed)
+	require.NoError(t, err)
+	assert.Equal(t, `"2021-03-04"`, string(b))
+
+	withTime := time.Date(2021, 3, 4, 23, 6, 7, 0, time.UTC)
+	b, err = MarshalDate(&withTime)
+	require.NoError(t, err)
+	assert.Equal(t, `"2021-03-04"`, string(b))
+
+	var zero time.Time
+	b, err = MarshalDate(&zero)
+	require.NoError(t, err)
+	assert.Equal(t, `"0001-01-01"`, string(b))
+	err = UnmarshalDate(b, &parsed)
+	require.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+
+	b, err = MarshalDate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(b))
+
+	err = UnmarshalDate([]byte(`null`), &parsed)
+	require.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+
+	for _, invalid := range []string{`"2021-03-04T05:06:07Z"`, `"03/04/2021"`, `20210304`} {
+		err = UnmarshalDate([]byte(invalid), &parsed)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	large, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	b, err := MarshalBigInt(large)
+	require.NoError(t, err)
+	assert.Equal(t, `123456789012345678901234567890`, string(b))
+
+	b, err = MarshalBigInt(nil)
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(b))
+
+	for _, valid := range []string{
+		`123456789012345678901234567890`,
+		`"123456789012345678901234567890"`,
+	} {
+		var parsed big.Int
+		err = UnmarshalBigInt([]byte(valid), &parsed)
+		require.NoError(t, err, valid)
+		assert.Equal(t, 0, large.Cmp(&parsed), valid)
+	}
+
+	var negative big.Int
+	err = UnmarshalBigInt([]byte(`"-12"`), &negative)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-12), negative.Int64())
+
+	err = UnmarshalBigInt([]byte(`null`), &negative)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), negative.Int64())
+
+	for _, invalid := range []string{`1.5`, `"twelve"`, `true`} {
+		var parsed big.Int
+		err = UnmarshalBigInt([]byte(invalid), &parsed)
+		assert.Error(t, err, invalid)
+	}
+}
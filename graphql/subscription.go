@@ -97,6 +97,17 @@ func (s *subscriptionMap) GetAllIDs() (subscriptionIDs []string) {
 	return subscriptionIDs
 }
 
+func (s *subscriptionMap) activeCount() (count int) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, sub := range s.map_ {
+		if !sub.hasBeenUnsubscribed() {
+			count++
+		}
+	}
+	return count
+}
+
 func (s *subscriptionMap) Delete(subscriptionID string) {
 	s.Lock()
 	defer s.Unlock()
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -20,6 +22,7 @@ const (
 	webSocketTypeConnAck    = "connection_ack"
 	webSocketTypeSubscribe  = "subscribe"
 	webSocketTypeNext       = "next"
+	webSocketTypeData       = "data" // legacy (graphql-ws protocol) name for "next"
 	webSocketTypeError      = "error"
 	webSocketTypeComplete   = "complete"
 	websocketConnAckTimeOut = time.Second * 30
@@ -49,12 +52,25 @@ type webSocketClient struct {
 	header     http.Header
 	connParams map[string]interface{}
 	// Closed when exiting the receive loop in listenWebSocket
-	errChan       chan error
-	endpoint      string
+	errChan  chan error
+	endpoint string
+	// If set, called whenever a subscription ends and no active
+	// subscriptions remain; used by lazyWebSocketClient.
+	onIdle        func()
 	subscriptions subscriptionMap
 
+	// Hold when writing to conn; WSConn implementations (like gorilla's) need
+	// not support concurrent writers, but Subscribe, Unsubscribe, and Close
+	// may be called from any goroutine.
+	writeMu sync.Mutex
+
 	// Hold when accessing `exitListenWebSocket`
 	exitListenWebSocketMu sync.Mutex
+
+	// Set by WithLazyConnect
+	lazyIdleTimeout time.Duration
+	lazy            bool
+
 	// Set to indicate the listenWebSocket should exit
 	exitListenWebSocket bool
 }
@@ -89,7 +105,15 @@ func (w *webSocketClient) sendStructAsJSON(object any) error {
 	if err != nil {
 		return err
 	}
-	return w.conn.WriteMessage(textMessage, jsonBytes)
+	return w.writeMessage(textMessage, jsonBytes)
+}
+
+// writeMessage writes a single frame to the connection. All writes must go
+// through here so that they are serialized.
+func (w *webSocketClient) writeMessage(messageType int, data []byte) error {
+	w.writeMu.Lock()
+	defer w.writeMu.Unlock()
+	return w.conn.WriteMessage(messageType, data)
 }
 
 func (w *webSocketClient) waitForConnAck() error {
@@ -102,7 +126,9 @@ func (w *webSocketClient) waitForConnAck() error {
 			return err
 		}
 		if time.Since(start) > websocketConnAckTimeOut {
-			return fmt.Errorf("timed out while waiting for connAck (> %v)", websocketConnAckTimeOut)
+			return &WebSocketProtocolError{
+				Reason: fmt.Sprintf("timed out while waiting for connection_ack (> %v)", websocketConnAckTimeOut),
+			}
 		}
 	}
 	return nil
@@ -131,7 +157,16 @@ func (w *webSocketClient) listenWebSocket() {
 		w.exitListenWebSocketMu.Unlock()
 		_, message, err := w.conn.ReadMessage()
 		if err != nil {
-			w.errChan <- err
+			// If we're exiting, the error is just the result of our closing
+			// the connection.
+			w.exitListenWebSocketMu.Lock()
+			exiting := w.exitListenWebSocket
+			w.exitListenWebSocketMu.Unlock()
+			if exiting {
+				close(w.errChan)
+				return
+			}
+			w.errChan <- asWebSocketCloseError(err)
 			return
 		}
 		err = w.forwardWebSocketData(message)
@@ -146,18 +181,32 @@ func (w *webSocketClient) forwardWebSocketData(message []byte) error {
 	var wsMsg webSocketReceiveMessage
 	err := json.Unmarshal(message, &wsMsg)
 	if err != nil {
-		return err
+		return &WebSocketProtocolError{
+			Reason:  "received malformed message",
+			Message: message,
+			Err:     err,
+		}
 	}
 	if wsMsg.ID == "" { // e.g. keep-alive messages
 		return nil
 	}
-	if wsMsg.Type == webSocketTypeComplete {
-		return w.subscriptions.Unsubscribe(wsMsg.ID)
+	switch wsMsg.Type {
+	case webSocketTypeNext, webSocketTypeData, webSocketTypeError:
+	case webSocketTypeComplete:
+		return w.endSubscription(wsMsg.ID)
+	default:
+		return &WebSocketProtocolError{
+			Reason:  fmt.Sprintf("received message of unknown type '%s'", wsMsg.Type),
+			Message: message,
+		}
 	}
 
 	sub, ok := w.subscriptions.GetSubscription(wsMsg.ID)
 	if !ok {
-		return fmt.Errorf("received message for unknown subscription ID '%s'", wsMsg.ID)
+		return &WebSocketProtocolError{
+			Reason:  fmt.Sprintf("received message for unknown subscription ID '%s'", wsMsg.ID),
+			Message: message,
+		}
 	}
 	// Note: there's no data race between hasBeenUnsubscribed and the closed
 	// state of interfaceChan because interfaceChan is only closed by the
@@ -165,13 +214,67 @@ func (w *webSocketClient) forwardWebSocketData(message []byte) error {
 	if sub.hasBeenUnsubscribed() {
 		return nil
 	}
-	return sub.forwardDataFunc(sub.interfaceChan, wsMsg.Payload)
+	if wsMsg.Type != webSocketTypeError {
+		return sub.forwardDataFunc(sub.interfaceChan, wsMsg.Payload)
+	}
+
+	// An error message means the server has terminated the subscription; we
+	// forward the errors as the subscription's final response, then end it
+	// (the channel is closed on the next iteration of listenWebSocket).
+	payload, err := errorMessagePayload(wsMsg.Payload)
+	if err != nil {
+		return &WebSocketProtocolError{
+			Reason:  "received malformed error message",
+			Message: message,
+			Err:     err,
+		}
+	}
+	err = sub.forwardDataFunc(sub.interfaceChan, payload)
+	if err != nil {
+		return err
+	}
+	return w.endSubscription(wsMsg.ID)
+}
+
+// endSubscription marks the given subscription as unsubscribed (its channel
+// will be closed by listenWebSocket), and calls onIdle if it was the last
+// active subscription.
+func (w *webSocketClient) endSubscription(subscriptionID string) error {
+	err := w.subscriptions.Unsubscribe(subscriptionID)
+	if err != nil {
+		return err
+	}
+	if w.onIdle != nil && w.subscriptions.activeCount() == 0 {
+		w.onIdle()
+	}
+	return nil
+}
+
+// errorMessagePayload converts the payload of an error message, which is a
+// list of GraphQL errors (or, in the legacy graphql-ws protocol, a single
+// error), into a response payload suitable for a ForwardDataFunction.
+func errorMessagePayload(payload json.RawMessage) (json.RawMessage, error) {
+	var errs gqlerror.List
+	trimmed := bytes.TrimSpace(payload)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var singleErr gqlerror.Error
+		if err := json.Unmarshal(trimmed, &singleErr); err != nil {
+			return nil, err
+		}
+		errs = gqlerror.List{&singleErr}
+	} else if err := json.Unmarshal(trimmed, &errs); err != nil {
+		return nil, err
+	}
+	if len(errs) == 0 {
+		errs = gqlerror.List{{Message: "subscription terminated by server error"}}
+	}
+	return json.Marshal(Response{Errors: errs})
 }
 
 func (w *webSocketClient) receiveWebSocketConnAck() (bool, error) {
 	_, message, err := w.conn.ReadMessage()
 	if err != nil {
-		return false, err
+		return false, asWebSocketCloseError(err)
 	}
 	return checkConnectionAckReceived(message)
 }
@@ -180,7 +283,11 @@ func checkConnectionAckReceived(message []byte) (bool, error) {
 	wsMessage := &webSocketSendMessage{}
 	err := json.Unmarshal(message, wsMessage)
 	if err != nil {
-		return false, err
+		return false, &WebSocketProtocolError{
+			Reason:  "received malformed message while waiting for connection_ack",
+			Message: message,
+			Err:     err,
+		}
 	}
 	return wsMessage.Type == webSocketTypeConnAck, nil
 }
@@ -190,12 +297,17 @@ func (w *webSocketClient) Start(ctx context.Context) (errChan chan error, err er
 	if err != nil {
 		return nil, err
 	}
+	// If ctx is done during the handshake, closing the connection interrupts
+	// any blocked read or write.
+	stop := context.AfterFunc(ctx, func() { w.conn.Close() })
 	err = w.sendInit()
-	if err != nil {
-		w.conn.Close()
-		return nil, err
+	if err == nil {
+		err = w.waitForConnAck()
+	}
+	if !stop() {
+		// (The connection is already closed; any error is a result.)
+		return nil, ctx.Err()
 	}
-	err = w.waitForConnAck()
 	if err != nil {
 		w.conn.Close()
 		return nil, err
@@ -212,7 +324,7 @@ func (w *webSocketClient) Close() error {
 	if err != nil {
 		return fmt.Errorf("failed to unsubscribe: %w", err)
 	}
-	err = w.conn.WriteMessage(closeMessage, formatCloseMessage(closeNormalClosure, ""))
+	err = w.writeMessage(closeMessage, formatCloseMessage(closeNormalClosure, ""))
 	if err != nil {
 		return fmt.Errorf("failed to send closure message: %w", err)
 	}
@@ -225,13 +337,9 @@ func (w *webSocketClient) Close() error {
 }
 
 func (w *webSocketClient) Subscribe(req *Request, interfaceChan interface{}, forwardDataFunc ForwardDataFunction) (string, error) {
-	if req.Query != "" {
-		if strings.HasPrefix(strings.TrimSpace(req.Query), "query") {
-			return "", fmt.Errorf("client does not support queries")
-		}
-		if strings.HasPrefix(strings.TrimSpace(req.Query), "mutation") {
-			return "", fmt.Errorf("client does not support mutations")
-		}
+	err := checkSubscriptionRequest(req)
+	if err != nil {
+		return "", err
 	}
 
 	subscriptionID := uuid.NewString()
@@ -241,7 +349,7 @@ func (w *webSocketClient) Subscribe(req *Request, interfaceChan interface{}, for
 		Payload: req,
 		ID:      subscriptionID,
 	}
-	err := w.sendStructAsJSON(subscriptionMsg)
+	err = w.sendStructAsJSON(subscriptionMsg)
 	if err != nil {
 		w.subscriptions.Delete(subscriptionID)
 		return "", err
@@ -249,6 +357,20 @@ func (w *webSocketClient) Subscribe(req *Request, interfaceChan interface{}, for
 	return subscriptionID, nil
 }
 
+// checkSubscriptionRequest returns an error if req is a query or mutation,
+// which are not supported over webSocket.
+func checkSubscriptionRequest(req *Request) error {
+	if req.Query != "" {
+		if strings.HasPrefix(strings.TrimSpace(req.Query), "query") {
+			return fmt.Errorf("client does not support queries")
+		}
+		if strings.HasPrefix(strings.TrimSpace(req.Query), "mutation") {
+			return fmt.Errorf("client does not support mutations")
+		}
+	}
+	return nil
+}
+
 func (w *webSocketClient) Unsubscribe(subscriptionID string) error {
 	completeMsg := webSocketSendMessage{
 		Type: webSocketTypeComplete,
@@ -258,7 +380,7 @@ func (w *webSocketClient) Unsubscribe(subscriptionID string) error {
 	if err != nil {
 		return err
 	}
-	err = w.subscriptions.Unsubscribe(subscriptionID)
+	err = w.endSubscription(subscriptionID)
 	if err != nil {
 		return err
 	}
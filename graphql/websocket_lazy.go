@@ -0,0 +1,327 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// lazyWebSocketClient is the [WebSocketClient] returned by
+// [NewClientUsingWebSocket] when [WithLazyConnect] is set.
+//
+// It wraps a webSocketClient for each connection it makes: a connection is
+// opened on the first call to Subscribe, and closed once it has had no active
+// subscriptions for the idle timeout, after which the next call to Subscribe
+// opens a new one.
+type lazyWebSocketClient struct {
+	// The client whose configuration is used for each new connection.
+	config *webSocketClient
+	// Errors from all connections are forwarded here, if Start was called.
+	errChan chan error
+	// Canceled by Close, to abort any connection in progress and stop any
+	// goroutines forwarding errors.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// The current connection, or nil if there is none.
+	conn *webSocketClient
+	// The connection in progress, if any.
+	dial      *lazyDial
+	idleTimer *time.Timer
+	// The number of calls to Subscribe writing to conn, which therefore
+	// mustn't be closed for idleness.
+	pendingSubscribes int
+
+	// Hold for reading when sending to errChan, and for writing when closing
+	// it. (We can't simply wait for the goroutines forwarding errors to exit,
+	// since a connection's listener may be blocked sending to a subscription
+	// channel nobody is reading.)
+	errChanMu sync.RWMutex
+
+	// Hold when accessing conn, dial, idleTimer, pendingSubscribes, started,
+	// and closed.  It's never held while connecting or writing to the
+	// connection, so that a slow server can't block Close.
+	mu      sync.Mutex
+	started bool
+	closed  bool
+}
+
+// lazyDial is the result of a connection attempt, shared by all the calls to
+// Subscribe which wait for it.
+type lazyDial struct {
+	// Closed when the attempt is finished, after which err is set.
+	done chan struct{}
+	err  error
+}
+
+func newLazyWebSocketClient(config *webSocketClient) *lazyWebSocketClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &lazyWebSocketClient{
+		config:  config,
+		errChan: make(chan error),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// newConnection returns a new, not yet started, webSocketClient with the same
+// configuration as w.
+func (w *webSocketClient) newConnection() *webSocketClient {
+	return &webSocketClient{
+		Dialer:        w.Dialer,
+		header:        w.header,
+		connParams:    w.connParams,
+		errChan:       make(chan error),
+		endpoint:      w.endpoint,
+		subscriptions: subscriptionMap{map_: make(map[string]*subscription)},
+	}
+}
+
+// closeAllSubscriptions ends all subscriptions and closes their channels.
+// It may only be called once listenWebSocket has exited, since it takes over
+// ownership of the channels.
+func (w *webSocketClient) closeAllSubscriptions() {
+	w.subscriptions.forEachSubscription(func(sub *subscription) {
+		sub.unsubscribe()
+		if sub.interfaceChan != nil {
+			reflect.ValueOf(sub.interfaceChan).Close()
+			sub.interfaceChan = nil
+		}
+	})
+}
+
+// Start does not connect; the connection is opened by the first call to
+// Subscribe. It returns a channel on which errors from all connections will
+// be sent; if Start is never called, such errors are discarded (although the
+// affected subscriptions' channels will still be closed).
+func (l *lazyWebSocketClient) Start(ctx context.Context) (errChan chan error, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil, errors.New("client is closed")
+	}
+	l.started = true
+	return l.errChan, nil
+}
+
+func (l *lazyWebSocketClient) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	conn := l.conn
+	l.conn = nil
+	pending := l.pendingSubscribes > 0
+	l.stopIdleTimerLocked()
+	l.mu.Unlock()
+	l.cancel()
+
+	var err error
+	switch {
+	case conn == nil:
+	case pending:
+		// A call to Subscribe is writing to the connection, and may be
+		// blocked if the server isn't reading, in which case so would any
+		// write of ours.  Closing the connection unblocks it; there's no
+		// point unsubscribing cleanly from a server that isn't listening.
+		conn.conn.Close()
+	default:
+		err = conn.Close()
+		if err != nil {
+			// Make sure the listener exits regardless.
+			conn.conn.Close()
+		}
+	}
+	l.errChanMu.Lock()
+	close(l.errChan)
+	l.errChanMu.Unlock()
+	return err
+}
+
+func (l *lazyWebSocketClient) Subscribe(req *Request, interfaceChan interface{}, forwardDataFunc ForwardDataFunction) (string, error) {
+	err := checkSubscriptionRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	conn, err := l.acquireConn()
+	if err != nil {
+		return "", err
+	}
+	// We don't hold the lock while subscribing, since writing to the
+	// connection may block; pendingSubscribes keeps the connection from being
+	// closed for idleness in the meantime.
+	subscriptionID, err := conn.Subscribe(req, interfaceChan, forwardDataFunc)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pendingSubscribes--
+	if err != nil {
+		l.scheduleIdleCloseLocked(conn)
+		return "", err
+	}
+	return subscriptionID, nil
+}
+
+// acquireConn returns the current connection, connecting if there is none,
+// and counts a pending subscribe on it, which the caller must uncount.
+func (l *lazyWebSocketClient) acquireConn() (*webSocketClient, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.conn == nil {
+		if l.closed {
+			return nil, errors.New("client is closed")
+		}
+		dial := l.dial
+		if dial == nil {
+			dial = &lazyDial{done: make(chan struct{})}
+			l.dial = dial
+			l.mu.Unlock()
+			l.connect(dial)
+		} else {
+			l.mu.Unlock()
+			<-dial.done
+		}
+		l.mu.Lock()
+		if dial.err != nil {
+			return nil, dial.err
+		}
+		// Otherwise, l.conn is the new connection -- unless it has already
+		// failed, in which case we try again.
+	}
+	if l.closed {
+		return nil, errors.New("client is closed")
+	}
+	l.stopIdleTimerLocked()
+	l.pendingSubscribes++
+	return l.conn, nil
+}
+
+func (l *lazyWebSocketClient) Unsubscribe(subscriptionID string) error {
+	l.mu.Lock()
+	conn := l.conn
+	l.mu.Unlock()
+	if conn == nil {
+		return fmt.Errorf("tried to unsubscribe from unknown subscription with ID '%s'", subscriptionID)
+	}
+	return conn.Unsubscribe(subscriptionID)
+}
+
+// connect opens a new connection and installs it as l.conn, then finishes
+// dial.  l.mu must not be held: connecting may take arbitrarily long, but
+// Close cancels it.
+func (l *lazyWebSocketClient) connect(dial *lazyDial) {
+	conn := l.config.newConnection()
+	conn.onIdle = func() { l.scheduleIdleClose(conn) }
+	_, err := conn.Start(l.ctx)
+
+	l.mu.Lock()
+	l.dial = nil
+	switch {
+	case l.closed:
+		dial.err = errors.New("client is closed")
+	case err != nil:
+		dial.err = err
+	default:
+		l.conn = conn
+		go l.forwardErrors(conn)
+	}
+	l.mu.Unlock()
+	close(dial.done)
+
+	if err == nil && dial.err != nil {
+		// We were closed after connecting; there's nobody to report an
+		// error to, so just make sure the connection is closed.
+		if conn.Close() != nil {
+			conn.conn.Close()
+		}
+	}
+}
+
+// forwardErrors waits for conn's listener to exit; if it exited due to an
+// error, it discards the connection and forwards the error to l.errChan.
+func (l *lazyWebSocketClient) forwardErrors(conn *webSocketClient) {
+	err, ok := <-conn.errChan
+	if !ok {
+		return // the connection was closed normally
+	}
+
+	l.mu.Lock()
+	if l.conn == conn {
+		l.conn = nil
+		l.stopIdleTimerLocked()
+	}
+	started := l.started
+	l.mu.Unlock()
+
+	// The listener has exited, so the connection is no longer usable; end its
+	// subscriptions so that their callers find out.
+	conn.conn.Close()
+	conn.closeAllSubscriptions()
+
+	if started {
+		l.sendError(err)
+	}
+}
+
+// sendError sends err to l.errChan, unless the client is closed first.
+func (l *lazyWebSocketClient) sendError(err error) {
+	l.errChanMu.RLock()
+	defer l.errChanMu.RUnlock()
+	select {
+	case <-l.ctx.Done():
+		return // errChan may be closed
+	default:
+	}
+	select {
+	case l.errChan <- err:
+	case <-l.ctx.Done():
+	}
+}
+
+func (l *lazyWebSocketClient) scheduleIdleClose(conn *webSocketClient) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.scheduleIdleCloseLocked(conn)
+}
+
+// scheduleIdleCloseLocked arranges for conn to be closed after the idle
+// timeout, if it's still the current connection and still has no active
+// (or pending) subscriptions then. l.mu must be held.
+func (l *lazyWebSocketClient) scheduleIdleCloseLocked(conn *webSocketClient) {
+	if l.conn != conn || l.pendingSubscribes > 0 || conn.subscriptions.activeCount() > 0 {
+		return
+	}
+	l.stopIdleTimerLocked()
+	l.idleTimer = time.AfterFunc(l.config.lazyIdleTimeout, func() { l.closeIfIdle(conn) })
+}
+
+func (l *lazyWebSocketClient) closeIfIdle(conn *webSocketClient) {
+	l.mu.Lock()
+	if l.conn != conn || l.pendingSubscribes > 0 || conn.subscriptions.activeCount() > 0 {
+		l.mu.Unlock()
+		return
+	}
+	l.conn = nil
+	l.idleTimer = nil
+	l.mu.Unlock()
+
+	// There's nobody to report an error to, so just make sure the connection
+	// is closed.
+	if err := conn.Close(); err != nil {
+		conn.conn.Close()
+	}
+}
+
+// stopIdleTimerLocked cancels any pending idle close. l.mu must be held.
+func (l *lazyWebSocketClient) stopIdleTimerLocked() {
+	if l.idleTimer != nil {
+		l.idleTimer.Stop()
+		l.idleTimer = nil
+	}
+}
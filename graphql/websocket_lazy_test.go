@@ -0,0 +1,302 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDialer is a fake Dialer which returns a new
+// concurrencyCheckingConn for each dial.
+type countingDialer struct {
+	conns []*concurrencyCheckingConn
+	mu    sync.Mutex
+}
+
+func (d *countingDialer) DialContext(context.Context, string, http.Header) (WSConn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	conn := newConcurrencyCheckingConn()
+	d.conns = append(d.conns, conn)
+	return conn, nil
+}
+
+func (d *countingDialer) dials() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.conns)
+}
+
+func (d *countingDialer) isClosed(i int) bool {
+	d.mu.Lock()
+	conn := d.conns[i]
+	d.mu.Unlock()
+	select {
+	case <-conn.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func lazyTestSubscribe(t *testing.T, wc WebSocketClient) (string, chan any) {
+	dataChan := make(chan any)
+	id, err := wc.Subscribe(
+		&Request{Query: "subscription { count }"},
+		dataChan,
+		func(interfaceChan any, jsonRawMsg json.RawMessage) error { return nil })
+	require.NoError(t, err)
+	return id, dataChan
+}
+
+func TestLazyWebSocketClient(t *testing.T) {
+	const idleTimeout = 20 * time.Millisecond
+
+	dialer := &countingDialer{}
+	wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(idleTimeout))
+
+	errChan, err := wc.Start(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, dialer.dials(), "Start should not connect")
+
+	id1, _ := lazyTestSubscribe(t, wc)
+	id2, _ := lazyTestSubscribe(t, wc)
+	assert.Equal(t, 1, dialer.dials(), "subscriptions should share a connection")
+
+	require.NoError(t, wc.Unsubscribe(id1))
+	time.Sleep(2 * idleTimeout)
+	assert.False(t, dialer.isClosed(0), "connection closed with active subscriptions")
+
+	require.NoError(t, wc.Unsubscribe(id2))
+	assert.Eventually(t, func() bool { return dialer.isClosed(0) },
+		time.Second, idleTimeout/4, "idle connection not closed")
+
+	// Subscribing again reconnects.
+	_, _ = lazyTestSubscribe(t, wc)
+	assert.Equal(t, 2, dialer.dials())
+	assert.False(t, dialer.isClosed(1))
+
+	require.NoError(t, wc.Close())
+	assert.True(t, dialer.isClosed(1))
+	_, ok := <-errChan
+	assert.False(t, ok, "errChan not closed")
+
+	_, err = wc.Subscribe(&Request{Query: "subscription { count }"}, make(chan any), nil)
+	assert.Error(t, err)
+}
+
+func TestLazyWebSocketClientResubscribeCancelsIdleClose(t *testing.T) {
+	const idleTimeout = 50 * time.Millisecond
+
+	dialer := &countingDialer{}
+	wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(idleTimeout))
+	defer wc.Close()
+
+	id, _ := lazyTestSubscribe(t, wc)
+	require.NoError(t, wc.Unsubscribe(id))
+	_, _ = lazyTestSubscribe(t, wc)
+
+	time.Sleep(2 * idleTimeout)
+	assert.Equal(t, 1, dialer.dials())
+	assert.False(t, dialer.isClosed(0))
+}
+
+func TestLazyWebSocketClientConnectionError(t *testing.T) {
+	dialer := &countingDialer{}
+	wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(time.Minute))
+	errChan, err := wc.Start(context.Background())
+	require.NoError(t, err)
+
+	_, dataChan := lazyTestSubscribe(t, wc)
+	// Simulate the server going away.
+	require.NoError(t, dialer.conns[0].Close())
+
+	select {
+	case err := <-errChan:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+	select {
+	case _, ok := <-dataChan:
+		assert.False(t, ok, "subscription channel not closed")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription channel to close")
+	}
+
+	_, _ = lazyTestSubscribe(t, wc)
+	assert.Equal(t, 2, dialer.dials())
+	require.NoError(t, wc.Close())
+}
+
+// blockingDialer is a fake Dialer which signals on dialing, then waits for
+// release (or for the context to be done) before returning a conn.  If
+// noAck is set, the conn never acknowledges the connection.
+type blockingDialer struct {
+	dialing chan struct{}
+	release chan struct{}
+	countingDialer
+	noAck bool
+}
+
+func newBlockingDialer(noAck bool) *blockingDialer {
+	return &blockingDialer{
+		dialing: make(chan struct{}, 10),
+		release: make(chan struct{}),
+		noAck:   noAck,
+	}
+}
+
+func (d *blockingDialer) DialContext(ctx context.Context, endpoint string, header http.Header) (WSConn, error) {
+	d.dialing <- struct{}{}
+	if !d.noAck {
+		select {
+		case <-d.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	conn, err := d.countingDialer.DialContext(ctx, endpoint, header)
+	if d.noAck {
+		// Already "sent" the ack, so reads block until the conn is closed.
+		conn.(*concurrencyCheckingConn).ackSent = true
+	}
+	return conn, err
+}
+
+func TestLazyWebSocketClientCloseWhileConnecting(t *testing.T) {
+	for _, noAck := range []bool{false, true} {
+		name := "Dial"
+		if noAck {
+			name = "Handshake"
+		}
+		t.Run(name, func(t *testing.T) {
+			dialer := newBlockingDialer(noAck)
+			wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(time.Minute))
+
+			subscribeErr := make(chan error)
+			go func() {
+				_, err := wc.Subscribe(&Request{Query: "subscription { count }"}, make(chan any), nil)
+				subscribeErr <- err
+			}()
+			<-dialer.dialing
+
+			// Neither Unsubscribe nor Close should wait for the connection.
+			closed := make(chan error)
+			go func() {
+				assert.Error(t, wc.Unsubscribe("nonexistent"))
+				closed <- wc.Close()
+			}()
+			select {
+			case err := <-closed:
+				assert.NoError(t, err)
+			case <-time.After(time.Second):
+				t.Fatal("Close blocked on connecting")
+			}
+			select {
+			case err := <-subscribeErr:
+				assert.Error(t, err)
+			case <-time.After(time.Second):
+				t.Fatal("Subscribe not canceled by Close")
+			}
+			if noAck {
+				assert.True(t, dialer.isClosed(0))
+			}
+		})
+	}
+}
+
+func TestLazyWebSocketClientConcurrentConnect(t *testing.T) {
+	const subscribers = 10
+
+	dialer := newBlockingDialer(false)
+	wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(time.Minute))
+	defer wc.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < subscribers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = lazyTestSubscribe(t, wc)
+		}()
+	}
+	<-dialer.dialing
+	// Give the other subscribers a chance to (wrongly) start dialing too.
+	time.Sleep(10 * time.Millisecond)
+	close(dialer.release)
+	wg.Wait()
+
+	assert.Equal(t, 1, dialer.dials(), "subscribers should share a connection")
+	conn := dialer.conns[0]
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	assert.Len(t, conn.messages, 1+subscribers)
+}
+
+// stallingDialer is a fake Dialer whose conns act like a server which has
+// stopped reading: writing a subscribe message signals on stalled, then
+// blocks until the conn is closed.
+type stallingDialer struct {
+	stalled chan struct{}
+	countingDialer
+}
+
+type stallingConn struct {
+	*concurrencyCheckingConn
+	stalled chan struct{}
+}
+
+func (d *stallingDialer) DialContext(ctx context.Context, endpoint string, header http.Header) (WSConn, error) {
+	conn, err := d.countingDialer.DialContext(ctx, endpoint, header)
+	if err != nil {
+		return nil, err
+	}
+	return &stallingConn{conn.(*concurrencyCheckingConn), d.stalled}, nil
+}
+
+func (c *stallingConn) WriteMessage(messageType int, data []byte) error {
+	var msg webSocketSendMessage
+	if messageType == textMessage && json.Unmarshal(data, &msg) == nil &&
+		msg.Type == webSocketTypeSubscribe {
+		c.stalled <- struct{}{}
+		<-c.closed
+		return errors.New("connection closed")
+	}
+	return c.concurrencyCheckingConn.WriteMessage(messageType, data)
+}
+
+func TestLazyWebSocketClientCloseWhileSubscribing(t *testing.T) {
+	dialer := &stallingDialer{stalled: make(chan struct{}, 1)}
+	wc := NewClientUsingWebSocket("ws://example.com", dialer, WithLazyConnect(time.Minute))
+
+	subscribeErr := make(chan error)
+	go func() {
+		_, err := wc.Subscribe(&Request{Query: "subscription { count }"}, make(chan any), nil)
+		subscribeErr <- err
+	}()
+	<-dialer.stalled
+
+	closed := make(chan error)
+	go func() { closed <- wc.Close() }()
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked on a stalled subscribe")
+	}
+	select {
+	case err := <-subscribeErr:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Subscribe not unblocked by Close")
+	}
+	assert.True(t, dialer.isClosed(0))
+}
@@ -1,9 +1,18 @@
 package graphql
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const testSubscriptionID = "test-subscription-id"
@@ -77,6 +86,30 @@ func Test_webSocketClient_forwardWebSocketData(t *testing.T) {
 			wc:      forgeTestWebSocketClient(false),
 			wantErr: false,
 		},
+		{
+			name:    "valid legacy data message",
+			args:    args{message: []byte(`{"type":"data","id":"test-subscription-id","payload":{"foo":"bar"}}`)},
+			wc:      forgeTestWebSocketClient(false),
+			wantErr: false,
+		},
+		{
+			name:    "valid error message",
+			args:    args{message: []byte(`{"type":"error","id":"test-subscription-id","payload":[{"message":"oh no"}]}`)},
+			wc:      forgeTestWebSocketClient(false),
+			wantErr: false,
+		},
+		{
+			name:    "malformed error message",
+			args:    args{message: []byte(`{"type":"error","id":"test-subscription-id","payload":"oh no"}`)},
+			wc:      forgeTestWebSocketClient(false),
+			wantErr: true,
+		},
+		{
+			name:    "unknown message type",
+			args:    args{message: []byte(`{"type":"bogus","id":"test-subscription-id","payload":{}}`)},
+			wc:      forgeTestWebSocketClient(false),
+			wantErr: true,
+		},
 	}
 	for i := range tests {
 		tt := &tests[i]
@@ -90,3 +123,205 @@ func Test_webSocketClient_forwardWebSocketData(t *testing.T) {
 		})
 	}
 }
+
+func Test_webSocketClient_forwardWebSocketData_protocolErrors(t *testing.T) {
+	for _, message := range []string{
+		`not json`,
+		`{"type":"next","id":"unknown-id","payload":{}}`,
+		`{"type":"bogus","id":"test-subscription-id","payload":{}}`,
+	} {
+		t.Run(message, func(t *testing.T) {
+			err := forgeTestWebSocketClient(false).forwardWebSocketData([]byte(message))
+			var protocolErr *WebSocketProtocolError
+			require.ErrorAs(t, err, &protocolErr)
+			assert.Equal(t, message, string(protocolErr.Message))
+		})
+	}
+}
+
+func Test_webSocketClient_forwardWebSocketData_errorEndsSubscription(t *testing.T) {
+	for name, payload := range map[string]string{
+		"list":   `[{"message":"oh no"},{"message":"not again"}]`,
+		"legacy": `{"message":"oh no"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var forwarded []json.RawMessage
+			wc := forgeTestWebSocketClient(false)
+			sub := wc.subscriptions.map_[testSubscriptionID]
+			sub.forwardDataFunc = func(interfaceChan any, jsonRawMsg json.RawMessage) error {
+				forwarded = append(forwarded, jsonRawMsg)
+				return nil
+			}
+
+			message := fmt.Sprintf(`{"type":"error","id":%q,"payload":%s}`, testSubscriptionID, payload)
+			err := wc.forwardWebSocketData([]byte(message))
+			require.NoError(t, err)
+
+			require.Len(t, forwarded, 1)
+			var resp Response
+			require.NoError(t, json.Unmarshal(forwarded[0], &resp))
+			require.NotEmpty(t, resp.Errors)
+			assert.Equal(t, "oh no", resp.Errors[0].Message)
+			assert.True(t, sub.hasBeenUnsubscribed())
+		})
+	}
+}
+
+func Test_asWebSocketCloseError(t *testing.T) {
+	gorillaErr := &websocket.CloseError{Code: WebSocketCloseUnauthorized, Text: "Unauthorized"}
+	tests := []struct {
+		err       error
+		wantClose *WebSocketCloseError
+		name      string
+	}{
+		{
+			name:      "gorilla close error",
+			err:       gorillaErr,
+			wantClose: &WebSocketCloseError{Code: 4401, Reason: "Unauthorized", Err: gorillaErr},
+		},
+		{
+			name: "wrapped gorilla close error",
+			err:  fmt.Errorf("read failed: %w", gorillaErr),
+			wantClose: &WebSocketCloseError{
+				Code: 4401, Reason: "Unauthorized", Err: fmt.Errorf("read failed: %w", gorillaErr),
+			},
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection reset"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := asWebSocketCloseError(tt.err)
+			var closeErr *WebSocketCloseError
+			if tt.wantClose == nil {
+				assert.False(t, errors.As(err, &closeErr))
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.ErrorAs(t, err, &closeErr)
+			assert.Equal(t, tt.wantClose, closeErr)
+			assert.ErrorIs(t, err, gorillaErr)
+		})
+	}
+}
+
+// concurrencyCheckingConn is a fake WSConn which acks the connection, then
+// blocks reads until closed, and records the messages written to it and any
+// concurrent calls to WriteMessage.
+type concurrencyCheckingConn struct {
+	closed           chan struct{}
+	messages         []webSocketSendMessage
+	concurrentWrites int
+	closeOnce        sync.Once
+	mu               sync.Mutex
+	ackSent          bool
+	writing          bool
+}
+
+func newConcurrencyCheckingConn() *concurrencyCheckingConn {
+	return &concurrencyCheckingConn{closed: make(chan struct{})}
+}
+
+func (c *concurrencyCheckingConn) ReadMessage() (int, []byte, error) {
+	c.mu.Lock()
+	if !c.ackSent {
+		c.ackSent = true
+		c.mu.Unlock()
+		return textMessage, []byte(`{"type":"connection_ack"}`), nil
+	}
+	c.mu.Unlock()
+	<-c.closed
+	return 0, nil, errors.New("connection closed")
+}
+
+func (c *concurrencyCheckingConn) WriteMessage(messageType int, data []byte) error {
+	c.mu.Lock()
+	if c.writing {
+		c.concurrentWrites++
+	}
+	c.writing = true
+	c.mu.Unlock()
+
+	// Give any concurrent writer a chance to show up.
+	time.Sleep(time.Millisecond)
+
+	var msg webSocketSendMessage
+	if messageType == textMessage {
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writing = false
+	if messageType == textMessage {
+		c.messages = append(c.messages, msg)
+	}
+	return nil
+}
+
+func (c *concurrencyCheckingConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+type fakeDialer struct{ conn WSConn }
+
+func (d fakeDialer) DialContext(context.Context, string, http.Header) (WSConn, error) {
+	return d.conn, nil
+}
+
+func TestWebSocketClientConcurrentWrites(t *testing.T) {
+	const goroutines = 50
+
+	conn := newConcurrencyCheckingConn()
+	wc := NewClientUsingWebSocket("ws://example.com", fakeDialer{conn})
+	errChan, err := wc.Start(context.Background())
+	require.NoError(t, err)
+	go func() {
+		for range errChan {
+		}
+	}()
+
+	var wg sync.WaitGroup
+	ids := make([]string, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := wc.Subscribe(
+				&Request{Query: "subscription { count }"},
+				make(chan any),
+				func(interfaceChan any, jsonRawMsg json.RawMessage) error { return nil })
+			assert.NoError(t, err)
+			assert.NoError(t, wc.Unsubscribe(id))
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+
+	conn.mu.Lock()
+	assert.Zero(t, conn.concurrentWrites)
+	// Each message arrived whole, and each subscription's messages arrived
+	// exactly once and in order: init, then subscribe, then complete.
+	require.Len(t, conn.messages, 1+2*goroutines)
+	assert.Equal(t, webSocketTypeConnInit, conn.messages[0].Type)
+	seen := map[string][]string{}
+	for _, msg := range conn.messages[1:] {
+		seen[msg.ID] = append(seen[msg.ID], msg.Type)
+	}
+	assert.Len(t, seen, goroutines)
+	for _, id := range ids {
+		assert.Equal(t,
+			[]string{webSocketTypeSubscribe, webSocketTypeComplete}, seen[id], id)
+	}
+	conn.mu.Unlock()
+
+	require.NoError(t, wc.Close())
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	assert.Zero(t, conn.concurrentWrites)
+}
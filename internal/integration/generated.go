@@ -933,6 +933,29 @@ var AllSpecies = []Species{
 	SpeciesCoelacanth,
 }
 
+// IsValid returns true if v is one of the values of Species known when this code was generated.
+func (v Species) IsValid() bool {
+	switch v {
+	case SpeciesDog, SpeciesCoelacanth:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the GraphQL value of v.
+func (v Species) String() string { return string(v) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Species) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Values not known when this code was generated are kept as-is; use IsValid to check for them.
+func (v *Species) UnmarshalText(text []byte) error {
+	*v = Species(text)
+	return nil
+}
+
 // UserFields includes the GraphQL fields of User requested by the fragment UserFields.
 type UserFields struct {
 	Id              string `json:"id"`
@@ -1012,6 +1035,48 @@ type __createUserInput struct {
 // GetUser returns __createUserInput.User, and is useful for accessing the field via an interface.
 func (v *__createUserInput) GetUser() NewUser { return v.User }
 
+// __flattenedqueryWithFlattenedFieldsMeUser is used internally by genqlient to (un)marshal the flattened field friends.
+type __flattenedqueryWithFlattenedFieldsMeUser struct {
+	Friends []queryWithFlattenedFieldsMeUserFriendsUser `json:"friends"`
+}
+
+// GetFriends returns __flattenedqueryWithFlattenedFieldsMeUser.Friends, and is useful for accessing the field via an interface.
+func (v *__flattenedqueryWithFlattenedFieldsMeUser) GetFriends() []queryWithFlattenedFieldsMeUserFriendsUser {
+	return v.Friends
+}
+
+func __unmarshalFlattenedqueryWithFlattenedFieldsMeUser(b []byte, v *[]queryWithFlattenedFieldsMeUserFriendsUser) error {
+	var wrapper __flattenedqueryWithFlattenedFieldsMeUser
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Friends
+	return err
+}
+
+func __marshalFlattenedqueryWithFlattenedFieldsMeUser(v *[]queryWithFlattenedFieldsMeUserFriendsUser) ([]byte, error) {
+	return json.Marshal(&__flattenedqueryWithFlattenedFieldsMeUser{Friends: *v})
+}
+
+// __flattenedqueryWithFlattenedFieldsUser is used internally by genqlient to (un)marshal the flattened field hair.
+type __flattenedqueryWithFlattenedFieldsUser struct {
+	Hair *queryWithFlattenedFieldsUserHair `json:"hair"`
+}
+
+// GetHair returns __flattenedqueryWithFlattenedFieldsUser.Hair, and is useful for accessing the field via an interface.
+func (v *__flattenedqueryWithFlattenedFieldsUser) GetHair() *queryWithFlattenedFieldsUserHair {
+	return v.Hair
+}
+
+func __unmarshalFlattenedqueryWithFlattenedFieldsUser(b []byte, v **queryWithFlattenedFieldsUserHair) error {
+	var wrapper __flattenedqueryWithFlattenedFieldsUser
+	err := json.Unmarshal(b, &wrapper)
+	*v = wrapper.Hair
+	return err
+}
+
+func __marshalFlattenedqueryWithFlattenedFieldsUser(v **queryWithFlattenedFieldsUserHair) ([]byte, error) {
+	return json.Marshal(&__flattenedqueryWithFlattenedFieldsUser{Hair: *v})
+}
+
 // __queryWithCustomMarshalInput is used internally by genqlient
 type __queryWithCustomMarshalInput struct {
 	Date time.Time `json:"-"`
@@ -1255,6 +1320,14 @@ type __queryWithFlattenInput struct {
 // GetIds returns __queryWithFlattenInput.Ids, and is useful for accessing the field via an interface.
 func (v *__queryWithFlattenInput) GetIds() []string { return v.Ids }
 
+// __queryWithFlattenedFieldsInput is used internally by genqlient
+type __queryWithFlattenedFieldsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __queryWithFlattenedFieldsInput.Id, and is useful for accessing the field via an interface.
+func (v *__queryWithFlattenedFieldsInput) GetId() string { return v.Id }
+
 // __queryWithFragmentsInput is used internally by genqlient
 type __queryWithFragmentsInput struct {
 	Ids []string `json:"ids"`
@@ -1303,6 +1376,14 @@ type __queryWithOmitemptyInput struct {
 // GetId returns __queryWithOmitemptyInput.Id, and is useful for accessing the field via an interface.
 func (v *__queryWithOmitemptyInput) GetId() string { return v.Id }
 
+// __queryWithUnknownImplementationsInput is used internally by genqlient
+type __queryWithUnknownImplementationsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __queryWithUnknownImplementationsInput.Id, and is useful for accessing the field via an interface.
+func (v *__queryWithUnknownImplementationsInput) GetId() string { return v.Id }
+
 // __queryWithVariablesInput is used internally by genqlient
 type __queryWithVariablesInput struct {
 	Id string `json:"id"`
@@ -1662,6 +1743,127 @@ func (v *queryWithCustomMarshalUsersBornOnUser) __premarshalJSON() (*__premarsha
 	return &retval, nil
 }
 
+// queryWithFlattenedFieldsMeUserFriendsUser includes the requested fields of the GraphQL type User.
+type queryWithFlattenedFieldsMeUserFriendsUser struct {
+	Name string `json:"name"`
+}
+
+// GetName returns queryWithFlattenedFieldsMeUserFriendsUser.Name, and is useful for accessing the field via an interface.
+func (v *queryWithFlattenedFieldsMeUserFriendsUser) GetName() string { return v.Name }
+
+// queryWithFlattenedFieldsResponse is returned by queryWithFlattenedFields on success.
+type queryWithFlattenedFieldsResponse struct {
+	User *queryWithFlattenedFieldsUserHair           `json:"-"`
+	Me   []queryWithFlattenedFieldsMeUserFriendsUser `json:"-"`
+}
+
+// GetUser returns queryWithFlattenedFieldsResponse.User, and is useful for accessing the field via an interface.
+func (v *queryWithFlattenedFieldsResponse) GetUser() *queryWithFlattenedFieldsUserHair { return v.User }
+
+// GetMe returns queryWithFlattenedFieldsResponse.Me, and is useful for accessing the field via an interface.
+func (v *queryWithFlattenedFieldsResponse) GetMe() []queryWithFlattenedFieldsMeUserFriendsUser {
+	return v.Me
+}
+
+func (v *queryWithFlattenedFieldsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*queryWithFlattenedFieldsResponse
+		User json.RawMessage `json:"user"`
+		Me   json.RawMessage `json:"me"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.queryWithFlattenedFieldsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.User
+		src := firstPass.User
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedqueryWithFlattenedFieldsUser(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal queryWithFlattenedFieldsResponse.User: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Me
+		src := firstPass.Me
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalFlattenedqueryWithFlattenedFieldsMeUser(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal queryWithFlattenedFieldsResponse.Me: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalqueryWithFlattenedFieldsResponse struct {
+	User json.RawMessage `json:"user"`
+
+	Me json.RawMessage `json:"me"`
+}
+
+func (v *queryWithFlattenedFieldsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *queryWithFlattenedFieldsResponse) __premarshalJSON() (*__premarshalqueryWithFlattenedFieldsResponse, error) {
+	var retval __premarshalqueryWithFlattenedFieldsResponse
+
+	{
+
+		dst := &retval.User
+		src := v.User
+		var err error
+		*dst, err = __marshalFlattenedqueryWithFlattenedFieldsUser(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryWithFlattenedFieldsResponse.User: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Me
+		src := v.Me
+		var err error
+		*dst, err = __marshalFlattenedqueryWithFlattenedFieldsMeUser(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryWithFlattenedFieldsResponse.Me: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// queryWithFlattenedFieldsUserHair includes the requested fields of the GraphQL type Hair.
+type queryWithFlattenedFieldsUserHair struct {
+	Color string `json:"color"`
+}
+
+// GetColor returns queryWithFlattenedFieldsUserHair.Color, and is useful for accessing the field via an interface.
+func (v *queryWithFlattenedFieldsUserHair) GetColor() string { return v.Color }
+
 // queryWithFragmentsBeingsAnimal includes the requested fields of the GraphQL type Animal.
 type queryWithFragmentsBeingsAnimal struct {
 	Typename string                                       `json:"__typename"`
@@ -3036,6 +3238,236 @@ func (v *queryWithOmitemptyUser) GetName() string { return v.Name }
 // GetLuckyNumber returns queryWithOmitemptyUser.LuckyNumber, and is useful for accessing the field via an interface.
 func (v *queryWithOmitemptyUser) GetLuckyNumber() int { return v.LuckyNumber }
 
+// queryWithUnknownImplementationsBeing includes the requested fields of the GraphQL interface Being.
+//
+// queryWithUnknownImplementationsBeing is implemented by the following types:
+// queryWithUnknownImplementationsBeingAnimal
+// queryWithUnknownImplementationsBeingUser
+// queryWithUnknownImplementationsBeingUnknown
+type queryWithUnknownImplementationsBeing interface {
+	implementsGraphQLInterfacequeryWithUnknownImplementationsBeing()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+	// GetName returns the interface-field "name" from its implementation.
+	GetName() string
+}
+
+func (v *queryWithUnknownImplementationsBeingAnimal) implementsGraphQLInterfacequeryWithUnknownImplementationsBeing() {
+}
+func (v *queryWithUnknownImplementationsBeingUser) implementsGraphQLInterfacequeryWithUnknownImplementationsBeing() {
+}
+func (v *queryWithUnknownImplementationsBeingUnknown) implementsGraphQLInterfacequeryWithUnknownImplementationsBeing() {
+}
+
+func __unmarshalqueryWithUnknownImplementationsBeing(b []byte, v *queryWithUnknownImplementationsBeing) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Animal":
+		*v = new(queryWithUnknownImplementationsBeingAnimal)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(queryWithUnknownImplementationsBeingUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Being.__typename")
+	default:
+		unknown := new(queryWithUnknownImplementationsBeingUnknown)
+		err = json.Unmarshal(b, unknown)
+		if err != nil {
+			return err
+		}
+		unknown.Raw = append(json.RawMessage(nil), b...)
+		*v = unknown
+		return nil
+	}
+}
+
+func __marshalqueryWithUnknownImplementationsBeing(v *queryWithUnknownImplementationsBeing) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *queryWithUnknownImplementationsBeingAnimal:
+		typename = "Animal"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*queryWithUnknownImplementationsBeingAnimal
+		}{typename, v}
+		return json.Marshal(result)
+	case *queryWithUnknownImplementationsBeingUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*queryWithUnknownImplementationsBeingUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *queryWithUnknownImplementationsBeingUnknown:
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for queryWithUnknownImplementationsBeing: "%T"`, v)
+	}
+}
+
+// queryWithUnknownImplementationsBeingAnimal includes the requested fields of the GraphQL type Animal.
+type queryWithUnknownImplementationsBeingAnimal struct {
+	Typename string                                                   `json:"__typename"`
+	Id       string                                                   `json:"id"`
+	Name     string                                                   `json:"name"`
+	Hair     queryWithUnknownImplementationsBeingAnimalHairBeingsHair `json:"hair"`
+}
+
+// GetTypename returns queryWithUnknownImplementationsBeingAnimal.Typename, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingAnimal) GetTypename() string { return v.Typename }
+
+// GetId returns queryWithUnknownImplementationsBeingAnimal.Id, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingAnimal) GetId() string { return v.Id }
+
+// GetName returns queryWithUnknownImplementationsBeingAnimal.Name, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingAnimal) GetName() string { return v.Name }
+
+// GetHair returns queryWithUnknownImplementationsBeingAnimal.Hair, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingAnimal) GetHair() queryWithUnknownImplementationsBeingAnimalHairBeingsHair {
+	return v.Hair
+}
+
+// queryWithUnknownImplementationsBeingAnimalHairBeingsHair includes the requested fields of the GraphQL type BeingsHair.
+type queryWithUnknownImplementationsBeingAnimalHairBeingsHair struct {
+	HasHair bool `json:"hasHair"`
+}
+
+// GetHasHair returns queryWithUnknownImplementationsBeingAnimalHairBeingsHair.HasHair, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingAnimalHairBeingsHair) GetHasHair() bool {
+	return v.HasHair
+}
+
+// queryWithUnknownImplementationsBeingUnknown is the implementation of queryWithUnknownImplementationsBeing for GraphQL types which were not known when this code was generated.  It includes the requested fields shared by all types.
+type queryWithUnknownImplementationsBeingUnknown struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	// Raw is the JSON of the object, as returned by the server.
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTypename returns queryWithUnknownImplementationsBeingUnknown.Typename, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUnknown) GetTypename() string { return v.Typename }
+
+// GetId returns queryWithUnknownImplementationsBeingUnknown.Id, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUnknown) GetId() string { return v.Id }
+
+// GetName returns queryWithUnknownImplementationsBeingUnknown.Name, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUnknown) GetName() string { return v.Name }
+
+// GetRaw returns queryWithUnknownImplementationsBeingUnknown.Raw, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUnknown) GetRaw() json.RawMessage { return v.Raw }
+
+// queryWithUnknownImplementationsBeingUser includes the requested fields of the GraphQL type User.
+type queryWithUnknownImplementationsBeingUser struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+}
+
+// GetTypename returns queryWithUnknownImplementationsBeingUser.Typename, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUser) GetTypename() string { return v.Typename }
+
+// GetId returns queryWithUnknownImplementationsBeingUser.Id, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUser) GetId() string { return v.Id }
+
+// GetName returns queryWithUnknownImplementationsBeingUser.Name, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsBeingUser) GetName() string { return v.Name }
+
+// queryWithUnknownImplementationsResponse is returned by queryWithUnknownImplementations on success.
+type queryWithUnknownImplementationsResponse struct {
+	Being queryWithUnknownImplementationsBeing `json:"-"`
+}
+
+// GetBeing returns queryWithUnknownImplementationsResponse.Being, and is useful for accessing the field via an interface.
+func (v *queryWithUnknownImplementationsResponse) GetBeing() queryWithUnknownImplementationsBeing {
+	return v.Being
+}
+
+func (v *queryWithUnknownImplementationsResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*queryWithUnknownImplementationsResponse
+		Being json.RawMessage `json:"being"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.queryWithUnknownImplementationsResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Being
+		src := firstPass.Being
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalqueryWithUnknownImplementationsBeing(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal queryWithUnknownImplementationsResponse.Being: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalqueryWithUnknownImplementationsResponse struct {
+	Being json.RawMessage `json:"being"`
+}
+
+func (v *queryWithUnknownImplementationsResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *queryWithUnknownImplementationsResponse) __premarshalJSON() (*__premarshalqueryWithUnknownImplementationsResponse, error) {
+	var retval __premarshalqueryWithUnknownImplementationsResponse
+
+	{
+
+		dst := &retval.Being
+		src := v.Being
+		var err error
+		*dst, err = __marshalqueryWithUnknownImplementationsBeing(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal queryWithUnknownImplementationsResponse.Being: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
 // queryWithVariablesResponse is returned by queryWithVariables on success.
 type queryWithVariablesResponse struct {
 	User queryWithVariablesUser `json:"user"`
@@ -3119,6 +3551,10 @@ subscription count {
 }
 `
 
+// The SHA-256 hash of count_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const count_OperationHash = "135c353beb00950297262a3dad0920fc50fe6967e46ef47c189e1c51b61043aa"
+
 // To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
 func count(
 	ctx_ context.Context,
@@ -3127,6 +3563,7 @@ func count(
 	req_ := &graphql.Request{
 		OpName: "count",
 		Query:  count_Operation,
+		Hash:   count_OperationHash,
 	}
 
 	dataChan_ = make(chan countWsResponse)
@@ -3167,6 +3604,10 @@ subscription countAuthorized {
 }
 `
 
+// The SHA-256 hash of countAuthorized_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const countAuthorized_OperationHash = "0d06000c48138ee0c54464f89c00fe668cb16c670d33b69709f45aee8f494793"
+
 // To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
 func countAuthorized(
 	ctx_ context.Context,
@@ -3175,6 +3616,7 @@ func countAuthorized(
 	req_ := &graphql.Request{
 		OpName: "countAuthorized",
 		Query:  countAuthorized_Operation,
+		Hash:   countAuthorized_OperationHash,
 	}
 
 	dataChan_ = make(chan countAuthorizedWsResponse)
@@ -3215,6 +3657,10 @@ subscription countClose {
 }
 `
 
+// The SHA-256 hash of countClose_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const countClose_OperationHash = "a967e165aee58ca19a7dbbbcbc937f9c79823e584dabd19844fa7c348443b50c"
+
 // To unsubscribe, use [graphql.WebSocketClient.Unsubscribe]
 func countClose(
 	ctx_ context.Context,
@@ -3223,6 +3669,7 @@ func countClose(
 	req_ := &graphql.Request{
 		OpName: "countClose",
 		Query:  countClose_Operation,
+		Hash:   countClose_OperationHash,
 	}
 
 	dataChan_ = make(chan countCloseWsResponse)
@@ -3266,6 +3713,10 @@ mutation createUser ($user: NewUser!) {
 }
 `
 
+// The SHA-256 hash of createUser_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const createUser_OperationHash = "d0b498bbb68833246cbc87183ca90fca732ee34b9754641d2c8b5f64a4eb870e"
+
 func createUser(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3274,6 +3725,7 @@ func createUser(
 	req_ := &graphql.Request{
 		OpName: "createUser",
 		Query:  createUser_Operation,
+		Hash:   createUser_OperationHash,
 		Variables: &__createUserInput{
 			User: user,
 		},
@@ -3301,6 +3753,10 @@ query failingQuery {
 }
 `
 
+// The SHA-256 hash of failingQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const failingQuery_OperationHash = "677a2f3124249b91399f40eb74f0ff420686619d9c1009575ac5cd3931f39f49"
+
 func failingQuery(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3308,6 +3764,7 @@ func failingQuery(
 	req_ := &graphql.Request{
 		OpName: "failingQuery",
 		Query:  failingQuery_Operation,
+		Hash:   failingQuery_OperationHash,
 	}
 
 	data_ = &failingQueryResponse{}
@@ -3333,6 +3790,10 @@ query queryWithCustomMarshal ($date: Date!) {
 }
 `
 
+// The SHA-256 hash of queryWithCustomMarshal_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithCustomMarshal_OperationHash = "e5f8b30b3d68d57121d7478381d85bcf1a6305d45eb66d3ea3d21fc044a8c4c7"
+
 func queryWithCustomMarshal(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3341,6 +3802,7 @@ func queryWithCustomMarshal(
 	req_ := &graphql.Request{
 		OpName: "queryWithCustomMarshal",
 		Query:  queryWithCustomMarshal_Operation,
+		Hash:   queryWithCustomMarshal_OperationHash,
 		Variables: &__queryWithCustomMarshalInput{
 			Date: date,
 		},
@@ -3369,6 +3831,10 @@ query queryWithCustomMarshalOptional ($date: Date, $id: ID) {
 }
 `
 
+// The SHA-256 hash of queryWithCustomMarshalOptional_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithCustomMarshalOptional_OperationHash = "6a6c56e3e3ed25e821621f75aac8d607b71cda40e42a3f0548d9b72d730737d3"
+
 func queryWithCustomMarshalOptional(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3378,6 +3844,7 @@ func queryWithCustomMarshalOptional(
 	req_ := &graphql.Request{
 		OpName: "queryWithCustomMarshalOptional",
 		Query:  queryWithCustomMarshalOptional_Operation,
+		Hash:   queryWithCustomMarshalOptional_OperationHash,
 		Variables: &__queryWithCustomMarshalOptionalInput{
 			Date: date,
 			Id:   id,
@@ -3407,6 +3874,10 @@ query queryWithCustomMarshalSlice ($dates: [Date!]!) {
 }
 `
 
+// The SHA-256 hash of queryWithCustomMarshalSlice_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithCustomMarshalSlice_OperationHash = "7835c46bb7f3f798972928efbea9e82fbd365b9e138e7d0c09faae7f61214f61"
+
 func queryWithCustomMarshalSlice(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3415,6 +3886,7 @@ func queryWithCustomMarshalSlice(
 	req_ := &graphql.Request{
 		OpName: "queryWithCustomMarshalSlice",
 		Query:  queryWithCustomMarshalSlice_Operation,
+		Hash:   queryWithCustomMarshalSlice_OperationHash,
 		Variables: &__queryWithCustomMarshalSliceInput{
 			Dates: dates,
 		},
@@ -3477,6 +3949,10 @@ fragment FriendsFields on User {
 }
 `
 
+// The SHA-256 hash of queryWithFlatten_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithFlatten_OperationHash = "12c67d5c437a31c50ba4fe38a93c7d1fd6d399b4b9fc4d93c3d1400ce0933b31"
+
 func queryWithFlatten(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3485,6 +3961,7 @@ func queryWithFlatten(
 	req_ := &graphql.Request{
 		OpName: "queryWithFlatten",
 		Query:  queryWithFlatten_Operation,
+		Hash:   queryWithFlatten_OperationHash,
 		Variables: &__queryWithFlattenInput{
 			Ids: ids,
 		},
@@ -3502,6 +3979,52 @@ func queryWithFlatten(
 	return data_, resp_.Extensions, err_
 }
 
+// The query executed by queryWithFlattenedFields.
+const queryWithFlattenedFields_Operation = `
+query queryWithFlattenedFields ($id: ID!) {
+	user(id: $id) {
+		hair {
+			color
+		}
+	}
+	me {
+		friends {
+			name
+		}
+	}
+}
+`
+
+// The SHA-256 hash of queryWithFlattenedFields_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithFlattenedFields_OperationHash = "8cec9c969c67a7abb0a4e66587ce93d8cded3b2c9fb67b985fa76fa129906a58"
+
+func queryWithFlattenedFields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *queryWithFlattenedFieldsResponse, ext_ map[string]interface{}, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "queryWithFlattenedFields",
+		Query:  queryWithFlattenedFields_Operation,
+		Hash:   queryWithFlattenedFields_OperationHash,
+		Variables: &__queryWithFlattenedFieldsInput{
+			Id: id,
+		},
+	}
+
+	data_ = &queryWithFlattenedFieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, resp_.Extensions, err_
+}
+
 // The query executed by queryWithFragments.
 const queryWithFragments_Operation = `
 query queryWithFragments ($ids: [ID!]!) {
@@ -3541,6 +4064,10 @@ query queryWithFragments ($ids: [ID!]!) {
 }
 `
 
+// The SHA-256 hash of queryWithFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithFragments_OperationHash = "3a1dafc6818a11c96a84ae3833deca81c7cb9ed7c56792ec89a43531129084c3"
+
 func queryWithFragments(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3549,6 +4076,7 @@ func queryWithFragments(
 	req_ := &graphql.Request{
 		OpName: "queryWithFragments",
 		Query:  queryWithFragments_Operation,
+		Hash:   queryWithFragments_OperationHash,
 		Variables: &__queryWithFragmentsInput{
 			Ids: ids,
 		},
@@ -3577,6 +4105,10 @@ query queryWithInterfaceListField ($ids: [ID!]!) {
 }
 `
 
+// The SHA-256 hash of queryWithInterfaceListField_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithInterfaceListField_OperationHash = "c0fca2eaea7c8afd42281e7ba818ed8438a6c6fd0462f566df840f329add6304"
+
 func queryWithInterfaceListField(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3585,6 +4117,7 @@ func queryWithInterfaceListField(
 	req_ := &graphql.Request{
 		OpName: "queryWithInterfaceListField",
 		Query:  queryWithInterfaceListField_Operation,
+		Hash:   queryWithInterfaceListField_OperationHash,
 		Variables: &__queryWithInterfaceListFieldInput{
 			Ids: ids,
 		},
@@ -3613,6 +4146,10 @@ query queryWithInterfaceListPointerField ($ids: [ID!]!) {
 }
 `
 
+// The SHA-256 hash of queryWithInterfaceListPointerField_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithInterfaceListPointerField_OperationHash = "08076e1dea044877f31e467923817f03cb7fe36263d7f9b9ed58476a06591623"
+
 func queryWithInterfaceListPointerField(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3621,6 +4158,7 @@ func queryWithInterfaceListPointerField(
 	req_ := &graphql.Request{
 		OpName: "queryWithInterfaceListPointerField",
 		Query:  queryWithInterfaceListPointerField_Operation,
+		Hash:   queryWithInterfaceListPointerField_OperationHash,
 		Variables: &__queryWithInterfaceListPointerFieldInput{
 			Ids: ids,
 		},
@@ -3653,6 +4191,10 @@ query queryWithInterfaceNoFragments ($id: ID!) {
 }
 `
 
+// The SHA-256 hash of queryWithInterfaceNoFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithInterfaceNoFragments_OperationHash = "2681bc9fdb0c73ca381686c92ae63264a616d55f015d9e48c88a9d7b4c232c36"
+
 func queryWithInterfaceNoFragments(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3661,6 +4203,7 @@ func queryWithInterfaceNoFragments(
 	req_ := &graphql.Request{
 		OpName: "queryWithInterfaceNoFragments",
 		Query:  queryWithInterfaceNoFragments_Operation,
+		Hash:   queryWithInterfaceNoFragments_OperationHash,
 		Variables: &__queryWithInterfaceNoFragmentsInput{
 			Id: id,
 		},
@@ -3717,6 +4260,10 @@ fragment MoreUserFields on User {
 }
 `
 
+// The SHA-256 hash of queryWithNamedFragments_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithNamedFragments_OperationHash = "21c3e4bc335f543744c90c2bc9d10bb01b5721a0d1d2ea0eb275a84b1cfa2279"
+
 func queryWithNamedFragments(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3725,6 +4272,7 @@ func queryWithNamedFragments(
 	req_ := &graphql.Request{
 		OpName: "queryWithNamedFragments",
 		Query:  queryWithNamedFragments_Operation,
+		Hash:   queryWithNamedFragments_OperationHash,
 		Variables: &__queryWithNamedFragmentsInput{
 			Ids: ids,
 		},
@@ -3753,6 +4301,10 @@ query queryWithOmitempty ($id: ID) {
 }
 `
 
+// The SHA-256 hash of queryWithOmitempty_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithOmitempty_OperationHash = "e4542fb626038502269f378063fba32b3f9eb9a125cc4e677368e0e5c679396f"
+
 func queryWithOmitempty(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3761,6 +4313,7 @@ func queryWithOmitempty(
 	req_ := &graphql.Request{
 		OpName: "queryWithOmitempty",
 		Query:  queryWithOmitempty_Operation,
+		Hash:   queryWithOmitempty_OperationHash,
 		Variables: &__queryWithOmitemptyInput{
 			Id: id,
 		},
@@ -3778,6 +4331,52 @@ func queryWithOmitempty(
 	return data_, resp_.Extensions, err_
 }
 
+// The query executed by queryWithUnknownImplementations.
+const queryWithUnknownImplementations_Operation = `
+query queryWithUnknownImplementations ($id: ID!) {
+	being(id: $id) {
+		__typename
+		id
+		name
+		... on Animal {
+			hair {
+				hasHair
+			}
+		}
+	}
+}
+`
+
+// The SHA-256 hash of queryWithUnknownImplementations_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithUnknownImplementations_OperationHash = "992f06c5734397588d7851178331bf04e9e401d1d0c13588d2edcee49df9d508"
+
+func queryWithUnknownImplementations(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *queryWithUnknownImplementationsResponse, ext_ map[string]interface{}, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "queryWithUnknownImplementations",
+		Query:  queryWithUnknownImplementations_Operation,
+		Hash:   queryWithUnknownImplementations_OperationHash,
+		Variables: &__queryWithUnknownImplementationsInput{
+			Id: id,
+		},
+	}
+
+	data_ = &queryWithUnknownImplementationsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, resp_.Extensions, err_
+}
+
 // The query executed by queryWithVariables.
 const queryWithVariables_Operation = `
 query queryWithVariables ($id: ID!) {
@@ -3789,6 +4388,10 @@ query queryWithVariables ($id: ID!) {
 }
 `
 
+// The SHA-256 hash of queryWithVariables_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const queryWithVariables_OperationHash = "f255964a2f7c75e832c3203518f265df56c647ee15553747727d4a5d46039ba7"
+
 func queryWithVariables(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3797,6 +4400,7 @@ func queryWithVariables(
 	req_ := &graphql.Request{
 		OpName: "queryWithVariables",
 		Query:  queryWithVariables_Operation,
+		Hash:   queryWithVariables_OperationHash,
 		Variables: &__queryWithVariablesInput{
 			Id: id,
 		},
@@ -3826,6 +4430,10 @@ query simpleQuery {
 }
 `
 
+// The SHA-256 hash of simpleQuery_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const simpleQuery_OperationHash = "00cb74983a818d0619d336793cd33b8ad5137b91c34416d111febeeda987ded0"
+
 func simpleQuery(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3833,6 +4441,7 @@ func simpleQuery(
 	req_ := &graphql.Request{
 		OpName: "simpleQuery",
 		Query:  simpleQuery_Operation,
+		Hash:   simpleQuery_OperationHash,
 	}
 
 	data_ = &simpleQueryResponse{}
@@ -3858,6 +4467,10 @@ query simpleQueryExt {
 }
 `
 
+// The SHA-256 hash of simpleQueryExt_Operation, which identifies it in
+// persisted-operation manifests (see export_operations in genqlient.yaml).
+const simpleQueryExt_OperationHash = "883f1002900c92f1590f1b5b735bf032c11f64a42371ac50c3e310b4da2c7ba1"
+
 func simpleQueryExt(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -3865,6 +4478,7 @@ func simpleQueryExt(
 	req_ := &graphql.Request{
 		OpName: "simpleQueryExt",
 		Query:  simpleQueryExt_Operation,
+		Hash:   simpleQueryExt_OperationHash,
 	}
 
 	data_ = &simpleQueryExtResponse{}
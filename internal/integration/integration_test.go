@@ -7,9 +7,14 @@ package integration
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -17,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/Khan/genqlient/generate"
 	"github.com/Khan/genqlient/graphql"
 	"github.com/Khan/genqlient/internal/integration/server"
 )
@@ -40,6 +46,32 @@ func TestSimpleQuery(t *testing.T) {
 	}
 }
 
+// recordingClient is a graphql.Client which records the requests it makes.
+type recordingClient struct {
+	graphql.Client
+	requests []*graphql.Request
+}
+
+func (c *recordingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.requests = append(c.requests, req)
+	return c.Client.MakeRequest(ctx, req, resp)
+}
+
+func TestOperationHash(t *testing.T) {
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	client := &recordingClient{Client: graphql.NewClient(server.URL, http.DefaultClient)}
+
+	_, _, err := simpleQuery(ctx, client)
+	require.NoError(t, err)
+
+	require.Len(t, client.requests, 1)
+	hash := sha256.Sum256([]byte(client.requests[0].Query))
+	assert.Equal(t, simpleQuery_OperationHash, hex.EncodeToString(hash[:]))
+	assert.Equal(t, simpleQuery_OperationHash, client.requests[0].Hash)
+}
+
 func TestMutation(t *testing.T) {
 	_ = `# @genqlient
 	mutation createUser($user: NewUser!) { createUser(input: $user) { id name } }`
@@ -150,6 +182,39 @@ func TestSubscription(t *testing.T) {
 	}
 }
 
+func TestSubscriptionLazyConnect(t *testing.T) {
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+
+	wsClient := newRoundtripWebSocketClient(t, server.URL, graphql.WithLazyConnect(10*time.Millisecond))
+	defer func() {
+		err := wsClient.Close()
+		require.NoError(t, err)
+	}()
+
+	// Run two subscriptions in sequence, without calling Start; the second
+	// will (typically) be on a new connection.
+	for i := 0; i < 2; i++ {
+		dataChan, subscriptionID, err := count(ctx, wsClient)
+		require.NoError(t, err)
+
+		select {
+		case resp, more := <-dataChan:
+			require.True(t, more)
+			require.NotNil(t, resp.Data)
+			assert.Equal(t, 0, resp.Data.Count)
+			require.Nil(t, resp.Errors)
+		case <-time.After(10 * time.Second):
+			require.NoError(t, fmt.Errorf("subscription timed out"))
+		}
+
+		err = wsClient.Unsubscribe(subscriptionID)
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
 func TestSubscriptionConnectionParams(t *testing.T) {
 	_ = `# @genqlient
 	subscription countAuthorized { countAuthorized }`
@@ -743,6 +808,7 @@ func TestFragments(t *testing.T) {
 		require.Truef(t, ok, "got %T, not Animal", resp.Beings[1])
 		assert.Equal(t, "3", animal.Id)
 		assert.Equal(t, SpeciesDog, animal.Species)
+		assert.True(t, animal.Species.IsValid())
 		assert.True(t, animal.Hair.HasHair)
 
 		assert.Equal(t, "1", animal.Owner.GetId())
@@ -977,6 +1043,152 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestFlattenField(t *testing.T) {
+	_ = `# @genqlient
+	query queryWithFlattenedFields($id: ID!) {
+		# @genqlient(flatten: true)
+		user(id: $id) {
+			# (Since flattening loses the distinction between a null
+			# user and a null hair, use a pointer to retain it.)
+			# @genqlient(pointer: true)
+			hair {
+				color
+			}
+		}
+		# @genqlient(flatten: true)
+		me {
+			friends {
+				name
+			}
+		}
+	}`
+
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	clients := newRoundtripClients(t, server.URL)
+
+	for _, client := range clients {
+		resp, _, err := queryWithFlattenedFields(ctx, client, "1")
+		require.NoError(t, err)
+
+		require.NotNil(t, resp.User)
+		assert.Equal(t, "Black", resp.User.Color)
+		require.Len(t, resp.Me, 1)
+		assert.Equal(t, "Raven", resp.Me[0].Name)
+
+		resp, _, err = queryWithFlattenedFields(ctx, client, "2")
+		require.NoError(t, err)
+
+		// Raven has no hair, so user.hair is null.
+		assert.Nil(t, resp.User)
+	}
+}
+
+func TestUnknownImplementations(t *testing.T) {
+	_ = `# @genqlient
+	query queryWithUnknownImplementations($id: ID!) {
+		# @genqlient(unknown_implementations: true)
+		being(id: $id) {
+			__typename
+			id
+			name
+			... on Animal { hair { hasHair } }
+		}
+	}`
+
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	clients := newRoundtripClients(t, server.URL)
+
+	for _, client := range clients {
+		resp, _, err := queryWithUnknownImplementations(ctx, client, "3")
+		require.NoError(t, err)
+
+		animal, ok := resp.Being.(*queryWithUnknownImplementationsBeingAnimal)
+		require.Truef(t, ok, "got %T, not Animal", resp.Being)
+		assert.Equal(t, "3", animal.Id)
+		assert.Equal(t, "Fido", animal.Name)
+	}
+
+	// The server doesn't have any types genqlient doesn't know about, so we
+	// check those by hand.
+	raw := `{"being":{"__typename":"Robot","id":"7","name":"R2-D2","model":"astromech"}}`
+	var resp queryWithUnknownImplementationsResponse
+	err := json.Unmarshal([]byte(raw), &resp)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Robot", resp.Being.GetTypename())
+	assert.Equal(t, "7", resp.Being.GetId())
+	assert.Equal(t, "R2-D2", resp.Being.GetName())
+
+	unknown, ok := resp.Being.(*queryWithUnknownImplementationsBeingUnknown)
+	require.Truef(t, ok, "got %T, not Unknown", resp.Being)
+	assert.JSONEq(t, `{"__typename":"Robot","id":"7","name":"R2-D2","model":"astromech"}`,
+		string(unknown.Raw))
+
+	b, err := json.Marshal(&resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"being":{"__typename":"Robot","id":"7","name":"R2-D2"}}`, string(b))
+}
+
+func TestEnumUnknownValues(t *testing.T) {
+	// By default, values added to the enum on the server since the code was
+	// generated are kept as-is.
+	var species []Species
+	err := json.Unmarshal([]byte(`["DOG", "CAT", null]`), &species)
+	require.NoError(t, err)
+	assert.Equal(t, []Species{SpeciesDog, "CAT", ""}, species)
+	assert.True(t, species[0].IsValid())
+	assert.False(t, species[1].IsValid())
+	assert.Equal(t, "CAT", species[1].String())
+
+	b, err := json.Marshal(species)
+	require.NoError(t, err)
+	assert.Equal(t, `["DOG","CAT",""]`, string(b))
+}
+
+func TestIntrospect(t *testing.T) {
+	ctx := context.Background()
+	server := server.RunServer()
+	defer server.Close()
+	client := graphql.NewClient(server.URL, http.DefaultClient)
+
+	config, err := generate.ReadAndValidateConfig(
+		filepath.Join(RepoRoot(t), "internal", "integration", "genqlient.yaml"))
+	require.NoError(t, err)
+	expected, err := generate.Generate(config)
+	require.NoError(t, err)
+
+	// The schema we fetch, in either format, should generate exactly the
+	// same code as the schema.graphql from which the server was built.
+	for _, format := range []string{"sdl", "json"} {
+		t.Run(format, func(t *testing.T) {
+			schema, err := generate.Introspect(ctx, client, format)
+			require.NoError(t, err)
+
+			again, err := generate.Introspect(ctx, client, format)
+			require.NoError(t, err)
+			assert.Equal(t, string(schema), string(again))
+
+			ext := "graphql"
+			if format == "json" {
+				ext = "json"
+			}
+			filename := filepath.Join(t.TempDir(), "schema."+ext)
+			err = os.WriteFile(filename, schema, 0o644)
+			require.NoError(t, err)
+
+			config.Schema = []string{filename}
+			generated, err := generate.Generate(config)
+			require.NoError(t, err)
+			assert.Equal(t,
+				string(expected[config.Generated]), string(generated[config.Generated]))
+		})
+	}
+}
+
 func TestGeneratedCode(t *testing.T) {
 	RunGenerateTest(t, "internal/integration/genqlient.yaml")
 }